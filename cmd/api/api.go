@@ -1,15 +1,25 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"github.com/go-chi/chi/v5"
 	"github.com/joho/godotenv"
 	"github.com/khorzhenwin/gold-digger/docs"
+	"github.com/khorzhenwin/gold-digger/internal/admin"
 	applicationConfig "github.com/khorzhenwin/gold-digger/internal/config"
 	"github.com/khorzhenwin/gold-digger/internal/db"
 	"github.com/khorzhenwin/gold-digger/internal/grpcapi"
 	"github.com/khorzhenwin/gold-digger/internal/health"
+	"github.com/khorzhenwin/gold-digger/internal/indicators"
+	"github.com/khorzhenwin/gold-digger/internal/market-data"
 	"github.com/khorzhenwin/gold-digger/internal/models"
 	"github.com/khorzhenwin/gold-digger/internal/notification"
+	"github.com/khorzhenwin/gold-digger/internal/price-alert"
+	"github.com/khorzhenwin/gold-digger/internal/price-bus"
+	"github.com/khorzhenwin/gold-digger/internal/signals"
+	"github.com/khorzhenwin/gold-digger/internal/strategy"
+	"github.com/khorzhenwin/gold-digger/internal/telegram-bot"
 	"github.com/khorzhenwin/gold-digger/internal/ticker-price"
 	"github.com/khorzhenwin/gold-digger/internal/watchlist"
 	_ "github.com/swaggo/files"
@@ -42,11 +52,41 @@ func (app *application) run() error {
 		log.Fatal(vErr)
 	}
 
+	marketDataCfg, mErr := applicationConfig.LoadMarketDataConfig()
+	if mErr != nil {
+		log.Fatal(mErr)
+	}
+
+	priceCacheCfg, cErr := applicationConfig.LoadPriceCacheConfig()
+	if cErr != nil {
+		log.Fatal(cErr)
+	}
+
+	gatewayCfg, gErr := applicationConfig.LoadGatewayConfig()
+	if gErr != nil {
+		log.Fatal(gErr)
+	}
+
 	notifierCfg, nErr := applicationConfig.LoadNotifierConfig()
 	if nErr != nil {
 		log.Fatal(nErr)
 	}
 
+	outboxCfg, oErr := applicationConfig.LoadOutboxConfig()
+	if oErr != nil {
+		log.Fatal(oErr)
+	}
+
+	notificationPolicyCfg, npErr := applicationConfig.LoadNotificationPolicyConfig()
+	if npErr != nil {
+		log.Fatal(npErr)
+	}
+
+	telegramBotCfg, tbErr := applicationConfig.LoadTelegramBotConfig()
+	if tbErr != nil {
+		log.Fatal(tbErr)
+	}
+
 	// 2. Initialize DB
 	cloudConn, err := db.NewAWSClient(cloudDbCfg)
 	if err != nil {
@@ -73,25 +113,77 @@ func (app *application) run() error {
 	if err := cloudConn.AutoMigrate(&models.Ticker{}); err != nil {
 		log.Fatalf("❌ AutoMigrate failed: %v", err)
 	}
+	if err := cloudConn.AutoMigrate(&models.PriceAlert{}); err != nil {
+		log.Fatalf("❌ AutoMigrate for PriceAlert failed: %v", err)
+	}
 	if err := localConn.AutoMigrate(&models.TickerPrice{}); err != nil {
 		log.Fatalf("❌ AutoMigrate for TickerPrice failed: %v", err)
 	}
+	if err := localConn.AutoMigrate(&models.SignalState{}); err != nil {
+		log.Fatalf("❌ AutoMigrate for SignalState failed: %v", err)
+	}
+	if err := localConn.AutoMigrate(&models.Signal{}); err != nil {
+		log.Fatalf("❌ AutoMigrate for Signal failed: %v", err)
+	}
+	if err := localConn.AutoMigrate(&models.OutboxMessage{}); err != nil {
+		log.Fatalf("❌ AutoMigrate for OutboxMessage failed: %v", err)
+	}
 	// Convert to hypertable
 	localConn.Exec("SELECT create_hypertable('ticker_prices', 'timestamp', if_not_exists => TRUE);")
 
 	watchlistRepo := watchlist.NewRepository(cloudConn)
-	watchlistService := watchlist.NewService(watchlistRepo)
+	strategyRegistry := strategy.NewRegistry()
+	watchlistService := watchlist.NewService(watchlistRepo, strategyRegistry)
 	notificationService := notification.NewService(notifierCfg)
+	log.Printf("🔔 Notifying via %v", notificationService.Channels())
+	// every notification goes through the outbox, so channel outages delay alerts instead of dropping them
+	messageTemplates, tErr := notification.NewTemplates(notifierCfg.TemplateDir)
+	if tErr != nil {
+		log.Fatalf("❌ Failed to load message templates: %v", tErr)
+	}
+	notificationOutbox := notification.NewOutbox(notification.NewOutboxRepository(localConn), notificationService, messageTemplates, outboxCfg, notificationPolicyCfg)
 	tickerPriceRepository := ticker_price.NewRepository(localConn)
-	tickerPriceService := ticker_price.NewService(watchlistService, vantageCfg, tickerPriceRepository)
-	grpcServer := grpcapi.NewServer(watchlistService, tickerPriceService)
+	signalStateRepository := ticker_price.NewSignalStateRepository(localConn)
+	quoteProvider, pErr := market_data.NewProvider(marketDataCfg, vantageCfg)
+	if pErr != nil {
+		log.Fatal(pErr)
+	}
+	log.Printf("📡 Using %s market data provider", quoteProvider.Name())
+	priceBus := price_bus.NewBus()
+	tickerPriceService := ticker_price.NewService(watchlistService, quoteProvider, tickerPriceRepository, priceBus, priceCacheCfg)
+	indicatorService := indicators.NewService(tickerPriceRepository)
+	signalService := signals.NewService(signals.NewRepository(localConn))
+	priceAlertService := price_alert.NewService(price_alert.NewRepository(cloudConn), watchlistService, notificationOutbox)
+	grpcServices := grpcapi.NewServices(watchlistService, tickerPriceService, indicatorService, signalService, priceAlertService)
+	grpcServer := grpcapi.NewServer(grpcServices)
+
+	// 3.1 Initialize Worker, fed with every price the poller saves.
+	// Subscribe before the poller starts so the first run is not missed.
+	signalSubscription := priceBus.Subscribe("signal-worker", 100, price_bus.Block)
+	app.lifecycle.Go("signal worker", func(ctx context.Context) error {
+		return ticker_price.StartSignalWorker(ctx, signalSubscription.C(), watchlistService, notificationOutbox, tickerPriceRepository, signalStateRepository, signalService, strategyRegistry)
+	})
+
+	priceAlertSubscription := priceBus.Subscribe("price-alerts", 100, price_bus.Block)
+	app.lifecycle.Go("price alert evaluator", func(ctx context.Context) error {
+		return priceAlertService.StartEvaluator(ctx, priceAlertSubscription.C())
+	})
+
+	app.lifecycle.Go("notification outbox", notificationOutbox.StartDelivery)
+
+	if notificationPolicyCfg.SummaryAt >= 0 {
+		app.lifecycle.Go("daily summary", func(ctx context.Context) error {
+			return ticker_price.StartDailySummary(ctx, notificationOutbox, tickerPriceRepository, watchlistService, notificationPolicyCfg)
+		})
+	}
 
-	// 3.1 Initialize Poller
-	go tickerPriceService.PollAndPersist()
+	if telegramBotCfg.Enabled {
+		telegramBot := telegram_bot.NewBot(telegram_bot.NewAPIClient(telegramBotCfg.BotToken), telegramBotCfg, watchlistService, tickerPriceService, signalService)
+		app.lifecycle.Go("telegram bot", telegramBot.Start)
+	}
 
-	// 3.2 Initialize Worker
-	tickerChan := make(chan models.TickerPrice, 100)
-	go ticker_price.StartSignalWorker(tickerChan, notificationService)
+	// 3.2 Initialize Poller
+	app.lifecycle.Go("ticker-price poller", tickerPriceService.PollAndPersist)
 
 	// 4. Setup Router config
 	r := chi.NewRouter()
@@ -100,12 +192,24 @@ func (app *application) run() error {
 	if err != nil {
 		log.Fatalf("failed to listen for gRPC on %s: %v", app.config.GRPC_ADDRESS, err)
 	}
-	go func() {
+	app.lifecycle.Go("gRPC server", func(context.Context) error {
 		log.Println("Starting gRPC server on", app.config.GRPC_ADDRESS)
-		if serveErr := grpcServer.Serve(grpcListener); serveErr != nil {
-			log.Fatalf("gRPC server failed: %v", serveErr)
+		return grpcServer.Serve(grpcListener)
+	})
+	app.lifecycle.OnShutdown("gRPC server", func(ctx context.Context) error {
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+			return nil
+		case <-ctx.Done():
+			grpcServer.Stop()
+			return ctx.Err()
 		}
-	}()
+	})
 
 	server := &http.Server{
 		Addr:         app.config.ADDRESS,
@@ -114,20 +218,47 @@ func (app *application) run() error {
 		ReadTimeout:  app.config.readTimeout,
 	}
 
-	// 5. Register all API routes
+	// 5. Register all API routes. Depending on REST_MODE the proto-backed resources are served by
+	// the chi handlers, by the generated grpc-gateway, or by both under separate base paths.
+	var gateway http.Handler
+	if gatewayCfg.Mode != applicationConfig.RestModeChi {
+		gateway, err = grpcapi.NewGateway(app.lifecycle.Context(), grpcServices, gatewayCfg.BasePath)
+		if err != nil {
+			log.Fatalf("failed to build REST gateway: %v", err)
+		}
+		log.Printf("Serving REST gateway under %s (REST_MODE=%s)", gatewayCfg.BasePath, gatewayCfg.Mode)
+	}
+
 	r.Route(app.config.BASE_PATH, func(r chi.Router) {
-		health.RegisterRoutes(r)
-		watchlist.RegisterRoutes(r, watchlistService)
-		ticker_price.RegisterRoutes(r, tickerPriceService)
+		if gatewayCfg.Mode != applicationConfig.RestModeGateway {
+			health.RegisterRoutes(r)
+			watchlist.RegisterRoutes(r, watchlistService)
+			price_alert.RegisterRoutes(r, priceAlertService)
+			ticker_price.RegisterRoutes(r, tickerPriceService)
+			indicators.RegisterRoutes(r, indicatorService)
+			signals.RegisterRoutes(r, signalService)
+		} else {
+			ticker_price.RegisterStreamRoutes(r, tickerPriceService)
+		}
+
+		// routes without a proto equivalent are always served by chi
+		admin.RegisterRoutes(r, quoteProvider, notificationOutbox)
+
+		if gateway != nil && gatewayCfg.BasePath == app.config.BASE_PATH {
+			r.Handle("/*", gateway)
+		}
 	})
+	if gateway != nil && gatewayCfg.BasePath != app.config.BASE_PATH {
+		r.Handle(gatewayCfg.BasePath+"/*", gateway)
+	}
 
 	// 6. Serve REST + gRPC OpenAPI docs in separate channels.
+	const grpcOpenAPIPath = "docs/openapi/grpc/proto/golddigger/v1/api.swagger.json"
 	r.Get("/openapi/rest/swagger.json", func(w http.ResponseWriter, _ *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		_, _ = io.WriteString(w, docs.SwaggerInfo.ReadDoc())
 	})
 	r.Get("/openapi/grpc/swagger.json", func(w http.ResponseWriter, req *http.Request) {
-		const grpcOpenAPIPath = "docs/openapi/grpc/proto/golddigger/v1/api.swagger.json"
 		if _, statErr := os.Stat(grpcOpenAPIPath); statErr != nil {
 			http.Error(w, "gRPC OpenAPI spec not found. Run `make proto`.", http.StatusNotFound)
 			return
@@ -137,10 +268,37 @@ func (app *application) run() error {
 	})
 	r.Get("/swagger/rest/*", httpSwagger.Handler(httpSwagger.URL("/openapi/rest/swagger.json")))
 	r.Get("/swagger/grpc/*", httpSwagger.Handler(httpSwagger.URL("/openapi/grpc/swagger.json")))
+
+	defaultSwagger := "/swagger/rest/index.html"
+	if gateway != nil {
+		// the gateway is documented by the proto's OpenAPI, with paths moved to its base path
+		r.Get("/openapi/gateway/swagger.json", func(w http.ResponseWriter, _ *http.Request) {
+			spec, readErr := os.ReadFile(grpcOpenAPIPath)
+			if readErr != nil {
+				http.Error(w, "gRPC OpenAPI spec not found. Run `make proto`.", http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(grpcapi.GatewayOpenAPI(spec, gatewayCfg.BasePath))
+		})
+		r.Get("/swagger/gateway/*", httpSwagger.Handler(httpSwagger.URL("/openapi/gateway/swagger.json")))
+		if gatewayCfg.Mode == applicationConfig.RestModeGateway {
+			defaultSwagger = "/swagger/gateway/index.html"
+		}
+	}
 	r.Get("/swagger/*", func(w http.ResponseWriter, req *http.Request) {
-		http.Redirect(w, req, "/swagger/rest/index.html", http.StatusTemporaryRedirect)
+		http.Redirect(w, req, defaultSwagger, http.StatusTemporaryRedirect)
+	})
+
+	app.lifecycle.Go("HTTP server", func(context.Context) error {
+		log.Println("Starting server on", app.config.ADDRESS)
+		if serveErr := server.ListenAndServe(); !errors.Is(serveErr, http.ErrServerClosed) {
+			return serveErr
+		}
+		return nil
 	})
+	app.lifecycle.OnShutdown("HTTP server", server.Shutdown)
 
-	log.Println("Starting server on", app.config.ADDRESS)
-	return server.ListenAndServe()
+	// 7. Block until SIGTERM/SIGINT, then drain workers and stop servers
+	return app.lifecycle.Wait()
 }
@@ -1,34 +1,42 @@
 package main
 
 import (
+	"github.com/khorzhenwin/gold-digger/internal/lifecycle"
 	"log"
 	"time"
+	_ "time/tzdata" // the slim runtime image ships without a zoneinfo database
 )
 
 type application struct {
-	config config
+	config    config
+	lifecycle *lifecycle.Manager
 }
 
 type config struct {
-	BASE_PATH    string
-	ADDRESS      string
-	GRPC_ADDRESS string
-	writeTimeout time.Duration
-	readTimeout  time.Duration
+	BASE_PATH       string
+	ADDRESS         string
+	GRPC_ADDRESS    string
+	writeTimeout    time.Duration
+	readTimeout     time.Duration
+	shutdownTimeout time.Duration
 }
 
 func main() {
 	cfg := config{
-		BASE_PATH:    "/api/v1",
-		ADDRESS:      ":8080",
-		GRPC_ADDRESS: ":9090",
-		writeTimeout: time.Second * 10,
-		readTimeout:  time.Second * 5,
+		BASE_PATH:       "/api/v1",
+		ADDRESS:         ":8080",
+		GRPC_ADDRESS:    ":9090",
+		writeTimeout:    time.Second * 10,
+		readTimeout:     time.Second * 5,
+		shutdownTimeout: time.Second * 30,
 	}
 
 	app := &application{
-		config: cfg,
+		config:    cfg,
+		lifecycle: lifecycle.NewManager(cfg.shutdownTimeout),
 	}
 
-	log.Fatal(app.run())
+	if err := app.run(); err != nil {
+		log.Fatal(err)
+	}
 }
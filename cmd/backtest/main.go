@@ -0,0 +1,149 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/joho/godotenv"
+	"github.com/khorzhenwin/gold-digger/internal/backtest"
+	applicationConfig "github.com/khorzhenwin/gold-digger/internal/config"
+	"github.com/khorzhenwin/gold-digger/internal/db"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"github.com/khorzhenwin/gold-digger/internal/strategy"
+	"github.com/khorzhenwin/gold-digger/internal/ticker-price"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// backtest replays a symbol's stored prices through the signal engine, e.g.
+//
+//	go run ./cmd/backtest -symbol AAPL -from 2025-06-01T00:00:00Z -min-change 0.015 -min-steps 3
+func main() {
+	var (
+		symbol     = flag.String("symbol", "", "symbol to replay (required)")
+		from       = flag.String("from", "", "start time in RFC3339, defaults to 30 days before -to")
+		to         = flag.String("to", "", "end time in RFC3339, defaults to now")
+		horizons   = flag.String("horizons", "1h,4h,1d", "comma separated forward return horizons, the first drives hit rate and drawdown")
+		minChange  = flag.Float64("min-change", 0.02, "momentum: minimum move over the window, 0.02 is 2%")
+		minSteps   = flag.Int("min-steps", 4, "momentum: minimum ticks moving in the signal's direction")
+		minSamples = flag.Int("min-samples", 5, "momentum: minimum prices in the window before evaluating")
+		asJSON     = flag.Bool("json", false, "print the report as JSON")
+	)
+	flag.Parse()
+
+	if *symbol == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	_ = godotenv.Load()
+
+	toTime := time.Now()
+	if *to != "" {
+		parsed, err := time.Parse(time.RFC3339, *to)
+		if err != nil {
+			log.Fatalf("invalid -to: %v", err)
+		}
+		toTime = parsed
+	}
+	fromTime := toTime.Add(-30 * 24 * time.Hour)
+	if *from != "" {
+		parsed, err := time.Parse(time.RFC3339, *from)
+		if err != nil {
+			log.Fatalf("invalid -from: %v", err)
+		}
+		fromTime = parsed
+	}
+
+	var horizonDurations []time.Duration
+	for _, value := range strings.Split(*horizons, ",") {
+		horizon, err := ticker_price.ParseInterval(value)
+		if err != nil || horizon == 0 {
+			log.Fatalf("invalid horizon %q", value)
+		}
+		horizonDurations = append(horizonDurations, horizon)
+	}
+
+	localDbCfg, err := applicationConfig.LoadLocalDBConfig()
+	if err != nil {
+		log.Fatal(err)
+	}
+	localConn, err := db.NewLocalDbClient(localDbCfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	prices, err := ticker_price.NewRepository(localConn).GetSince(*symbol, fromTime)
+	if err != nil {
+		log.Fatalf("failed to load prices: %v", err)
+	}
+	inRange := make([]models.TickerPrice, 0, len(prices))
+	for _, price := range prices {
+		if price.Timestamp.After(toTime) {
+			break
+		}
+		inRange = append(inRange, price)
+	}
+
+	registry := strategy.NewRegistry()
+	registry.Register(&strategy.MomentumStrategy{MinChange: *minChange, MinSteps: *minSteps, MinSamples: *minSamples})
+
+	report, err := backtest.Run(backtest.Config{
+		Symbol:   *symbol,
+		Registry: registry,
+		Horizons: horizonDurations,
+	}, inRange)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	printReport(report)
+}
+
+func printReport(report *backtest.Report) {
+	fmt.Printf("📊 Backtest %s from %s to %s: %d ticks, %d evaluations, %d signals\n\n",
+		report.Symbol, report.From.Format(time.RFC3339), report.To.Format(time.RFC3339),
+		report.Ticks, report.Evaluations, len(report.Signals))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	header := "FIRED AT\tSTRATEGY\tSIDE\tPRICE\tCHANGE"
+	for _, stats := range report.Horizons {
+		header += "\t+" + stats.Horizon.String()
+	}
+	_, _ = fmt.Fprintln(w, header+"\tDRAWDOWN")
+	for _, result := range report.Signals {
+		row := fmt.Sprintf("%s\t%s\t%s\t%.2f\t%.2f%%",
+			result.FiredAt.Format(time.RFC3339), result.Signal.Strategy, result.Signal.Side,
+			result.Signal.Price, result.Signal.ChangePct)
+		for _, forward := range result.Returns {
+			if forward.Available {
+				row += fmt.Sprintf("\t%.2f%%", forward.ReturnPct)
+			} else {
+				row += "\t-"
+			}
+		}
+		_, _ = fmt.Fprintln(w, row+fmt.Sprintf("\t%.2f%%", result.MaxDrawdownPct))
+	}
+	_ = w.Flush()
+
+	fmt.Println()
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "HORIZON\tSAMPLES\tHIT RATE\tAVG RETURN")
+	for _, stats := range report.Horizons {
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%.1f%%\t%.2f%%\n", stats.Horizon, stats.Samples, stats.HitRate*100, stats.AvgReturnPct)
+	}
+	_ = w.Flush()
+
+	fmt.Printf("\nMax drawdown of the equity curve: %.2f%%\n", report.MaxDrawdownPct)
+}
@@ -157,29 +157,1280 @@ func (x *GetHealthResponse) GetHealth() *HealthResponse {
 }
 
 type TickerPrice struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Symbol    string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Price     float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Where the price was served from: cache, database or provider. Only set by GetTickerPrice.
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	// When the price was observed, clients can use it to judge staleness.
+	AsOf *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	// Exchange session the price's timestamp falls in: regular, pre, post or closed. Pre and post
+	// are only recorded when the provider quotes extended hours. Empty on prices stored before
+	// sessions were recorded.
+	Session       string `protobuf:"bytes,6,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TickerPrice) Reset() {
+	*x = TickerPrice{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TickerPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TickerPrice) ProtoMessage() {}
+
+func (x *TickerPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TickerPrice.ProtoReflect.Descriptor instead.
+func (*TickerPrice) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TickerPrice) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *TickerPrice) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *TickerPrice) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *TickerPrice) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TickerPrice) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *TickerPrice) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+type GetTickerPriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticker        string                 `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTickerPriceRequest) Reset() {
+	*x = GetTickerPriceRequest{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTickerPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickerPriceRequest) ProtoMessage() {}
+
+func (x *GetTickerPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickerPriceRequest.ProtoReflect.Descriptor instead.
+func (*GetTickerPriceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTickerPriceRequest) GetTicker() string {
+	if x != nil {
+		return x.Ticker
+	}
+	return ""
+}
+
+type StreamTickerPricesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Symbols to stream, at least one is required.
+	Tickers []string `protobuf:"bytes,1,rep,name=tickers,proto3" json:"tickers,omitempty"`
+	// Number of latest stored points per ticker to replay, oldest first, before live prices. At most 500.
+	Replay        int32 `protobuf:"varint,2,opt,name=replay,proto3" json:"replay,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamTickerPricesRequest) Reset() {
+	*x = StreamTickerPricesRequest{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamTickerPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamTickerPricesRequest) ProtoMessage() {}
+
+func (x *StreamTickerPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamTickerPricesRequest.ProtoReflect.Descriptor instead.
+func (*StreamTickerPricesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StreamTickerPricesRequest) GetTickers() []string {
+	if x != nil {
+		return x.Tickers
+	}
+	return nil
+}
+
+func (x *StreamTickerPricesRequest) GetReplay() int32 {
+	if x != nil {
+		return x.Replay
+	}
+	return 0
+}
+
+type GetTickerPriceHistoryRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Ticker string                 `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// Defaults to 7 days before `to`.
+	From *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// Defaults to now.
+	To *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// Bucket size such as 5m, 1h or 1d. Raw prices are returned when empty or "raw".
+	Interval      string `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTickerPriceHistoryRequest) Reset() {
+	*x = GetTickerPriceHistoryRequest{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTickerPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickerPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetTickerPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickerPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetTickerPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTickerPriceHistoryRequest) GetTicker() string {
+	if x != nil {
+		return x.Ticker
+	}
+	return ""
+}
+
+func (x *GetTickerPriceHistoryRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetTickerPriceHistoryRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetTickerPriceHistoryRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+type PriceCandle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Open          float64                `protobuf:"fixed64,2,opt,name=open,proto3" json:"open,omitempty"`
+	High          float64                `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
+	Low           float64                `protobuf:"fixed64,4,opt,name=low,proto3" json:"low,omitempty"`
+	Close         float64                `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
+	Volume        int64                  `protobuf:"varint,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	Count         int64                  `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceCandle) Reset() {
+	*x = PriceCandle{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceCandle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceCandle) ProtoMessage() {}
+
+func (x *PriceCandle) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceCandle.ProtoReflect.Descriptor instead.
+func (*PriceCandle) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PriceCandle) GetBucket() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+func (x *PriceCandle) GetOpen() float64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *PriceCandle) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *PriceCandle) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *PriceCandle) GetClose() float64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *PriceCandle) GetVolume() int64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *PriceCandle) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetTickerPriceHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticker        string                 `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	Interval      string                 `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	Prices        []*TickerPrice         `protobuf:"bytes,3,rep,name=prices,proto3" json:"prices,omitempty"`
+	Candles       []*PriceCandle         `protobuf:"bytes,4,rep,name=candles,proto3" json:"candles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTickerPriceHistoryResponse) Reset() {
+	*x = GetTickerPriceHistoryResponse{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTickerPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickerPriceHistoryResponse) ProtoMessage() {}
+
+func (x *GetTickerPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickerPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetTickerPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetTickerPriceHistoryResponse) GetTicker() string {
+	if x != nil {
+		return x.Ticker
+	}
+	return ""
+}
+
+func (x *GetTickerPriceHistoryResponse) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *GetTickerPriceHistoryResponse) GetPrices() []*TickerPrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *GetTickerPriceHistoryResponse) GetCandles() []*PriceCandle {
+	if x != nil {
+		return x.Candles
+	}
+	return nil
+}
+
+type GetIndicatorSeriesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Ticker string                 `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// One of sma, ema, rsi, macd, bollinger, atr, vwap.
+	Indicator string `protobuf:"bytes,2,opt,name=indicator,proto3" json:"indicator,omitempty"`
+	// Defaults to 7 days before `to`.
+	From *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// Defaults to now.
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Period        int32                  `protobuf:"varint,5,opt,name=period,proto3" json:"period,omitempty"`
+	FastPeriod    int32                  `protobuf:"varint,6,opt,name=fast_period,json=fastPeriod,proto3" json:"fast_period,omitempty"`
+	SlowPeriod    int32                  `protobuf:"varint,7,opt,name=slow_period,json=slowPeriod,proto3" json:"slow_period,omitempty"`
+	SignalPeriod  int32                  `protobuf:"varint,8,opt,name=signal_period,json=signalPeriod,proto3" json:"signal_period,omitempty"`
+	StdDev        float64                `protobuf:"fixed64,9,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIndicatorSeriesRequest) Reset() {
+	*x = GetIndicatorSeriesRequest{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIndicatorSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIndicatorSeriesRequest) ProtoMessage() {}
+
+func (x *GetIndicatorSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIndicatorSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetIndicatorSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetIndicatorSeriesRequest) GetTicker() string {
+	if x != nil {
+		return x.Ticker
+	}
+	return ""
+}
+
+func (x *GetIndicatorSeriesRequest) GetIndicator() string {
+	if x != nil {
+		return x.Indicator
+	}
+	return ""
+}
+
+func (x *GetIndicatorSeriesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetIndicatorSeriesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetIndicatorSeriesRequest) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *GetIndicatorSeriesRequest) GetFastPeriod() int32 {
+	if x != nil {
+		return x.FastPeriod
+	}
+	return 0
+}
+
+func (x *GetIndicatorSeriesRequest) GetSlowPeriod() int32 {
+	if x != nil {
+		return x.SlowPeriod
+	}
+	return 0
+}
+
+func (x *GetIndicatorSeriesRequest) GetSignalPeriod() int32 {
+	if x != nil {
+		return x.SignalPeriod
+	}
+	return 0
+}
+
+func (x *GetIndicatorSeriesRequest) GetStdDev() float64 {
+	if x != nil {
+		return x.StdDev
+	}
+	return 0
+}
+
+type IndicatorPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Values        map[string]float64     `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IndicatorPoint) Reset() {
+	*x = IndicatorPoint{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndicatorPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndicatorPoint) ProtoMessage() {}
+
+func (x *IndicatorPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndicatorPoint.ProtoReflect.Descriptor instead.
+func (*IndicatorPoint) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *IndicatorPoint) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *IndicatorPoint) GetValues() map[string]float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type IndicatorSeries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticker        string                 `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	Indicator     string                 `protobuf:"bytes,2,opt,name=indicator,proto3" json:"indicator,omitempty"`
+	Points        []*IndicatorPoint      `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IndicatorSeries) Reset() {
+	*x = IndicatorSeries{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndicatorSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndicatorSeries) ProtoMessage() {}
+
+func (x *IndicatorSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndicatorSeries.ProtoReflect.Descriptor instead.
+func (*IndicatorSeries) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *IndicatorSeries) GetTicker() string {
+	if x != nil {
+		return x.Ticker
+	}
+	return ""
+}
+
+func (x *IndicatorSeries) GetIndicator() string {
+	if x != nil {
+		return x.Indicator
+	}
+	return ""
+}
+
+func (x *IndicatorSeries) GetPoints() []*IndicatorPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type Signal struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symbol   string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Strategy string                 `protobuf:"bytes,3,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// BUY or DIP.
+	Direction   string                 `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	Strength    float64                `protobuf:"fixed64,5,opt,name=strength,proto3" json:"strength,omitempty"`
+	Reason      string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Price       float64                `protobuf:"fixed64,7,opt,name=price,proto3" json:"price,omitempty"`
+	ChangePct   float64                `protobuf:"fixed64,8,opt,name=change_pct,json=changePct,proto3" json:"change_pct,omitempty"`
+	WindowStart *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	// Whether the notification for this signal was delivered.
+	Sent          bool                   `protobuf:"varint,11,opt,name=sent,proto3" json:"sent,omitempty"`
+	SendError     string                 `protobuf:"bytes,12,opt,name=send_error,json=sendError,proto3" json:"send_error,omitempty"`
+	SentAt        *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Signal) Reset() {
+	*x = Signal{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Signal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Signal) ProtoMessage() {}
+
+func (x *Signal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Signal.ProtoReflect.Descriptor instead.
+func (*Signal) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Signal) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Signal) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Signal) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *Signal) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *Signal) GetStrength() float64 {
+	if x != nil {
+		return x.Strength
+	}
+	return 0
+}
+
+func (x *Signal) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Signal) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Signal) GetChangePct() float64 {
+	if x != nil {
+		return x.ChangePct
+	}
+	return 0
+}
+
+func (x *Signal) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *Signal) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *Signal) GetSent() bool {
+	if x != nil {
+		return x.Sent
+	}
+	return false
+}
+
+func (x *Signal) GetSendError() string {
+	if x != nil {
+		return x.SendError
+	}
+	return ""
+}
+
+func (x *Signal) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+func (x *Signal) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListSignalsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Symbol   string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Strategy string                 `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// Defaults to 7 days before `to`.
+	From *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// Defaults to now.
+	To *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// Defaults to 100, at most 1000.
+	Limit         int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSignalsRequest) Reset() {
+	*x = ListSignalsRequest{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSignalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSignalsRequest) ProtoMessage() {}
+
+func (x *ListSignalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSignalsRequest.ProtoReflect.Descriptor instead.
+func (*ListSignalsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListSignalsRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ListSignalsRequest) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *ListSignalsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListSignalsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListSignalsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListSignalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signals       []*Signal              `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSignalsResponse) Reset() {
+	*x = ListSignalsResponse{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSignalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSignalsResponse) ProtoMessage() {}
+
+func (x *ListSignalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSignalsResponse.ProtoReflect.Descriptor instead.
+func (*ListSignalsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListSignalsResponse) GetSignals() []*Signal {
+	if x != nil {
+		return x.Signals
+	}
+	return nil
+}
+
+type WatchlistItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Symbol    string                 `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Notes     string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Replaced as a whole on update, zero values keep the worker's defaults.
+	Alert *AlertConfig `protobuf:"bytes,6,opt,name=alert,proto3" json:"alert,omitempty"`
+	// Market identifier code scheduling the symbol's polling: XNYS, XNAS, XLON, XSES, XHKG or
+	// CRYPTO. Left empty on create or update it is inferred from the symbol's suffix.
+	Exchange string `protobuf:"bytes,7,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	// When the symbol is polled: regular (default), extended for pre-market and after hours,
+	// 04:00 to 20:00 ET on US exchanges, or always.
+	SessionMode   string `protobuf:"bytes,8,opt,name=session_mode,json=sessionMode,proto3" json:"session_mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchlistItem) Reset() {
+	*x = WatchlistItem{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistItem) ProtoMessage() {}
+
+func (x *WatchlistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistItem.ProtoReflect.Descriptor instead.
+func (*WatchlistItem) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WatchlistItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WatchlistItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WatchlistItem) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *WatchlistItem) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *WatchlistItem) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *WatchlistItem) GetAlert() *AlertConfig {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+func (x *WatchlistItem) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *WatchlistItem) GetSessionMode() string {
+	if x != nil {
+		return x.SessionMode
+	}
+	return ""
+}
+
+type AlertConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Fractional move that triggers momentum, 0.05 is 5%. Defaults to 0.02.
+	MinChange float64 `protobuf:"fixed64,1,opt,name=min_change,json=minChange,proto3" json:"min_change,omitempty"`
+	// Ticks moving in the signal's direction. Defaults to 4.
+	MinSteps int32 `protobuf:"varint,2,opt,name=min_steps,json=minSteps,proto3" json:"min_steps,omitempty"`
+	// Prices kept per window. Defaults to 10.
+	WindowSize int32 `protobuf:"varint,3,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
+	// Minutes between signals. Defaults to 60.
+	CooldownMinutes int32 `protobuf:"varint,4,opt,name=cooldown_minutes,json=cooldownMinutes,proto3" json:"cooldown_minutes,omitempty"`
+	// Strategies to evaluate, empty enables the default strategies.
+	Strategies []string `protobuf:"bytes,5,rep,name=strategies,proto3" json:"strategies,omitempty"`
+	Muted      bool     `protobuf:"varint,6,opt,name=muted,proto3" json:"muted,omitempty"`
+	// Signals stay silent until this time passes, unset when not muted for a while. Left unset
+	// on update it keeps the current timed mute, a time in the past lifts it.
+	MutedUntil *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"`
+	// Strategies that also evaluate pre-market and after-hours ticks, the others only see the
+	// regular session.
+	ExtendedHoursStrategies []string `protobuf:"bytes,8,rep,name=extended_hours_strategies,json=extendedHoursStrategies,proto3" json:"extended_hours_strategies,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *AlertConfig) Reset() {
+	*x = AlertConfig{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertConfig) ProtoMessage() {}
+
+func (x *AlertConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertConfig.ProtoReflect.Descriptor instead.
+func (*AlertConfig) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AlertConfig) GetMinChange() float64 {
+	if x != nil {
+		return x.MinChange
+	}
+	return 0
+}
+
+func (x *AlertConfig) GetMinSteps() int32 {
+	if x != nil {
+		return x.MinSteps
+	}
+	return 0
+}
+
+func (x *AlertConfig) GetWindowSize() int32 {
+	if x != nil {
+		return x.WindowSize
+	}
+	return 0
+}
+
+func (x *AlertConfig) GetCooldownMinutes() int32 {
+	if x != nil {
+		return x.CooldownMinutes
+	}
+	return 0
+}
+
+func (x *AlertConfig) GetStrategies() []string {
+	if x != nil {
+		return x.Strategies
+	}
+	return nil
+}
+
+func (x *AlertConfig) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+func (x *AlertConfig) GetMutedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return nil
+}
+
+func (x *AlertConfig) GetExtendedHoursStrategies() []string {
+	if x != nil {
+		return x.ExtendedHoursStrategies
+	}
+	return nil
+}
+
+type ListWatchlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWatchlistRequest) Reset() {
+	*x = ListWatchlistRequest{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchlistRequest) ProtoMessage() {}
+
+func (x *ListWatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchlistRequest.ProtoReflect.Descriptor instead.
+func (*ListWatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{17}
+}
+
+type ListWatchlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*WatchlistItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWatchlistResponse) Reset() {
+	*x = ListWatchlistResponse{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWatchlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchlistResponse) ProtoMessage() {}
+
+func (x *ListWatchlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchlistResponse.ProtoReflect.Descriptor instead.
+func (*ListWatchlistResponse) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListWatchlistResponse) GetItems() []*WatchlistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CreateWatchlistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticker        *WatchlistItem         `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWatchlistItemRequest) Reset() {
+	*x = CreateWatchlistItemRequest{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWatchlistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWatchlistItemRequest) ProtoMessage() {}
+
+func (x *CreateWatchlistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWatchlistItemRequest.ProtoReflect.Descriptor instead.
+func (*CreateWatchlistItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateWatchlistItemRequest) GetTicker() *WatchlistItem {
+	if x != nil {
+		return x.Ticker
+	}
+	return nil
+}
+
+type UpdateWatchlistItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ticker        *WatchlistItem         `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TickerPrice) Reset() {
-	*x = TickerPrice{}
-	mi := &file_proto_golddigger_v1_api_proto_msgTypes[3]
+func (x *UpdateWatchlistItemRequest) Reset() {
+	*x = UpdateWatchlistItemRequest{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TickerPrice) String() string {
+func (x *UpdateWatchlistItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TickerPrice) ProtoMessage() {}
+func (*UpdateWatchlistItemRequest) ProtoMessage() {}
 
-func (x *TickerPrice) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_golddigger_v1_api_proto_msgTypes[3]
+func (x *UpdateWatchlistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -190,54 +1441,47 @@ func (x *TickerPrice) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TickerPrice.ProtoReflect.Descriptor instead.
-func (*TickerPrice) Descriptor() ([]byte, []int) {
-	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *TickerPrice) GetSymbol() string {
-	if x != nil {
-		return x.Symbol
-	}
-	return ""
+// Deprecated: Use UpdateWatchlistItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWatchlistItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *TickerPrice) GetPrice() float64 {
+func (x *UpdateWatchlistItemRequest) GetId() uint64 {
 	if x != nil {
-		return x.Price
+		return x.Id
 	}
 	return 0
 }
 
-func (x *TickerPrice) GetTimestamp() *timestamppb.Timestamp {
+func (x *UpdateWatchlistItemRequest) GetTicker() *WatchlistItem {
 	if x != nil {
-		return x.Timestamp
+		return x.Ticker
 	}
 	return nil
 }
 
-type GetTickerPriceRequest struct {
+type DeleteWatchlistItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ticker        string                 `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTickerPriceRequest) Reset() {
-	*x = GetTickerPriceRequest{}
-	mi := &file_proto_golddigger_v1_api_proto_msgTypes[4]
+func (x *DeleteWatchlistItemRequest) Reset() {
+	*x = DeleteWatchlistItemRequest{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTickerPriceRequest) String() string {
+func (x *DeleteWatchlistItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTickerPriceRequest) ProtoMessage() {}
+func (*DeleteWatchlistItemRequest) ProtoMessage() {}
 
-func (x *GetTickerPriceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_golddigger_v1_api_proto_msgTypes[4]
+func (x *DeleteWatchlistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -248,44 +1492,56 @@ func (x *GetTickerPriceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTickerPriceRequest.ProtoReflect.Descriptor instead.
-func (*GetTickerPriceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use DeleteWatchlistItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWatchlistItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetTickerPriceRequest) GetTicker() string {
+func (x *DeleteWatchlistItemRequest) GetId() uint64 {
 	if x != nil {
-		return x.Ticker
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type WatchlistItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Symbol        string                 `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Notes         string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+type PriceAlert struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TickerId uint64                 `protobuf:"varint,2,opt,name=ticker_id,json=tickerId,proto3" json:"ticker_id,omitempty"`
+	Symbol   string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// above, below, pct_up or pct_down.
+	Condition string `protobuf:"bytes,4,opt,name=condition,proto3" json:"condition,omitempty"`
+	// A price, or a percent for pct_up and pct_down.
+	Level float64 `protobuf:"fixed64,5,opt,name=level,proto3" json:"level,omitempty"`
+	// Anchors percent alerts, the next polled price is used when 0.
+	ReferencePrice float64 `protobuf:"fixed64,6,opt,name=reference_price,json=referencePrice,proto3" json:"reference_price,omitempty"`
+	// Re-arm once the price crosses back instead of firing only once.
+	Repeat        bool                   `protobuf:"varint,7,opt,name=repeat,proto3" json:"repeat,omitempty"`
+	Armed         bool                   `protobuf:"varint,8,opt,name=armed,proto3" json:"armed,omitempty"`
+	TriggerCount  int32                  `protobuf:"varint,9,opt,name=trigger_count,json=triggerCount,proto3" json:"trigger_count,omitempty"`
+	TriggeredAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=triggered_at,json=triggeredAt,proto3" json:"triggered_at,omitempty"`
+	Note          string                 `protobuf:"bytes,11,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WatchlistItem) Reset() {
-	*x = WatchlistItem{}
-	mi := &file_proto_golddigger_v1_api_proto_msgTypes[5]
+func (x *PriceAlert) Reset() {
+	*x = PriceAlert{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WatchlistItem) String() string {
+func (x *PriceAlert) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WatchlistItem) ProtoMessage() {}
+func (*PriceAlert) ProtoMessage() {}
 
-func (x *WatchlistItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_golddigger_v1_api_proto_msgTypes[5]
+func (x *PriceAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -296,67 +1552,124 @@ func (x *WatchlistItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WatchlistItem.ProtoReflect.Descriptor instead.
-func (*WatchlistItem) Descriptor() ([]byte, []int) {
-	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use PriceAlert.ProtoReflect.Descriptor instead.
+func (*PriceAlert) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *WatchlistItem) GetId() uint64 {
+func (x *PriceAlert) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *WatchlistItem) GetCreatedAt() *timestamppb.Timestamp {
+func (x *PriceAlert) GetTickerId() uint64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.TickerId
 	}
-	return nil
+	return 0
 }
 
-func (x *WatchlistItem) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *PriceAlert) GetSymbol() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Symbol
 	}
-	return nil
+	return ""
 }
 
-func (x *WatchlistItem) GetSymbol() string {
+func (x *PriceAlert) GetCondition() string {
 	if x != nil {
-		return x.Symbol
+		return x.Condition
 	}
 	return ""
 }
 
-func (x *WatchlistItem) GetNotes() string {
+func (x *PriceAlert) GetLevel() float64 {
 	if x != nil {
-		return x.Notes
+		return x.Level
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetReferencePrice() float64 {
+	if x != nil {
+		return x.ReferencePrice
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetRepeat() bool {
+	if x != nil {
+		return x.Repeat
+	}
+	return false
+}
+
+func (x *PriceAlert) GetArmed() bool {
+	if x != nil {
+		return x.Armed
+	}
+	return false
+}
+
+func (x *PriceAlert) GetTriggerCount() int32 {
+	if x != nil {
+		return x.TriggerCount
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetTriggeredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TriggeredAt
+	}
+	return nil
+}
+
+func (x *PriceAlert) GetNote() string {
+	if x != nil {
+		return x.Note
 	}
 	return ""
 }
 
-type ListWatchlistRequest struct {
+func (x *PriceAlert) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PriceAlert) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListPriceAlertsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	TickerId      uint64                 `protobuf:"varint,1,opt,name=ticker_id,json=tickerId,proto3" json:"ticker_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListWatchlistRequest) Reset() {
-	*x = ListWatchlistRequest{}
-	mi := &file_proto_golddigger_v1_api_proto_msgTypes[6]
+func (x *ListPriceAlertsRequest) Reset() {
+	*x = ListPriceAlertsRequest{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListWatchlistRequest) String() string {
+func (x *ListPriceAlertsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWatchlistRequest) ProtoMessage() {}
+func (*ListPriceAlertsRequest) ProtoMessage() {}
 
-func (x *ListWatchlistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_golddigger_v1_api_proto_msgTypes[6]
+func (x *ListPriceAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -367,33 +1680,40 @@ func (x *ListWatchlistRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWatchlistRequest.ProtoReflect.Descriptor instead.
-func (*ListWatchlistRequest) Descriptor() ([]byte, []int) {
-	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListPriceAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListPriceAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{23}
 }
 
-type ListWatchlistResponse struct {
+func (x *ListPriceAlertsRequest) GetTickerId() uint64 {
+	if x != nil {
+		return x.TickerId
+	}
+	return 0
+}
+
+type ListPriceAlertsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*WatchlistItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Alerts        []*PriceAlert          `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListWatchlistResponse) Reset() {
-	*x = ListWatchlistResponse{}
-	mi := &file_proto_golddigger_v1_api_proto_msgTypes[7]
+func (x *ListPriceAlertsResponse) Reset() {
+	*x = ListPriceAlertsResponse{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListWatchlistResponse) String() string {
+func (x *ListPriceAlertsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWatchlistResponse) ProtoMessage() {}
+func (*ListPriceAlertsResponse) ProtoMessage() {}
 
-func (x *ListWatchlistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_golddigger_v1_api_proto_msgTypes[7]
+func (x *ListPriceAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -404,40 +1724,41 @@ func (x *ListWatchlistResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWatchlistResponse.ProtoReflect.Descriptor instead.
-func (*ListWatchlistResponse) Descriptor() ([]byte, []int) {
-	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListPriceAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListPriceAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ListWatchlistResponse) GetItems() []*WatchlistItem {
+func (x *ListPriceAlertsResponse) GetAlerts() []*PriceAlert {
 	if x != nil {
-		return x.Items
+		return x.Alerts
 	}
 	return nil
 }
 
-type CreateWatchlistItemRequest struct {
+type CreatePriceAlertRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ticker        *WatchlistItem         `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	TickerId      uint64                 `protobuf:"varint,1,opt,name=ticker_id,json=tickerId,proto3" json:"ticker_id,omitempty"`
+	Alert         *PriceAlert            `protobuf:"bytes,2,opt,name=alert,proto3" json:"alert,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateWatchlistItemRequest) Reset() {
-	*x = CreateWatchlistItemRequest{}
-	mi := &file_proto_golddigger_v1_api_proto_msgTypes[8]
+func (x *CreatePriceAlertRequest) Reset() {
+	*x = CreatePriceAlertRequest{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateWatchlistItemRequest) String() string {
+func (x *CreatePriceAlertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateWatchlistItemRequest) ProtoMessage() {}
+func (*CreatePriceAlertRequest) ProtoMessage() {}
 
-func (x *CreateWatchlistItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_golddigger_v1_api_proto_msgTypes[8]
+func (x *CreatePriceAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -448,41 +1769,49 @@ func (x *CreateWatchlistItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateWatchlistItemRequest.ProtoReflect.Descriptor instead.
-func (*CreateWatchlistItemRequest) Descriptor() ([]byte, []int) {
-	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CreatePriceAlertRequest.ProtoReflect.Descriptor instead.
+func (*CreatePriceAlertRequest) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CreateWatchlistItemRequest) GetTicker() *WatchlistItem {
+func (x *CreatePriceAlertRequest) GetTickerId() uint64 {
 	if x != nil {
-		return x.Ticker
+		return x.TickerId
+	}
+	return 0
+}
+
+func (x *CreatePriceAlertRequest) GetAlert() *PriceAlert {
+	if x != nil {
+		return x.Alert
 	}
 	return nil
 }
 
-type UpdateWatchlistItemRequest struct {
+type UpdatePriceAlertRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Ticker        *WatchlistItem         `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	TickerId      uint64                 `protobuf:"varint,1,opt,name=ticker_id,json=tickerId,proto3" json:"ticker_id,omitempty"`
+	AlertId       uint64                 `protobuf:"varint,2,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	Alert         *PriceAlert            `protobuf:"bytes,3,opt,name=alert,proto3" json:"alert,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateWatchlistItemRequest) Reset() {
-	*x = UpdateWatchlistItemRequest{}
-	mi := &file_proto_golddigger_v1_api_proto_msgTypes[9]
+func (x *UpdatePriceAlertRequest) Reset() {
+	*x = UpdatePriceAlertRequest{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateWatchlistItemRequest) String() string {
+func (x *UpdatePriceAlertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateWatchlistItemRequest) ProtoMessage() {}
+func (*UpdatePriceAlertRequest) ProtoMessage() {}
 
-func (x *UpdateWatchlistItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_golddigger_v1_api_proto_msgTypes[9]
+func (x *UpdatePriceAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,47 +1822,55 @@ func (x *UpdateWatchlistItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateWatchlistItemRequest.ProtoReflect.Descriptor instead.
-func (*UpdateWatchlistItemRequest) Descriptor() ([]byte, []int) {
-	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use UpdatePriceAlertRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePriceAlertRequest) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *UpdateWatchlistItemRequest) GetId() uint64 {
+func (x *UpdatePriceAlertRequest) GetTickerId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.TickerId
 	}
 	return 0
 }
 
-func (x *UpdateWatchlistItemRequest) GetTicker() *WatchlistItem {
+func (x *UpdatePriceAlertRequest) GetAlertId() uint64 {
 	if x != nil {
-		return x.Ticker
+		return x.AlertId
+	}
+	return 0
+}
+
+func (x *UpdatePriceAlertRequest) GetAlert() *PriceAlert {
+	if x != nil {
+		return x.Alert
 	}
 	return nil
 }
 
-type DeleteWatchlistItemRequest struct {
+type DeletePriceAlertRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TickerId      uint64                 `protobuf:"varint,1,opt,name=ticker_id,json=tickerId,proto3" json:"ticker_id,omitempty"`
+	AlertId       uint64                 `protobuf:"varint,2,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteWatchlistItemRequest) Reset() {
-	*x = DeleteWatchlistItemRequest{}
-	mi := &file_proto_golddigger_v1_api_proto_msgTypes[10]
+func (x *DeletePriceAlertRequest) Reset() {
+	*x = DeletePriceAlertRequest{}
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteWatchlistItemRequest) String() string {
+func (x *DeletePriceAlertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteWatchlistItemRequest) ProtoMessage() {}
+func (*DeletePriceAlertRequest) ProtoMessage() {}
 
-func (x *DeleteWatchlistItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_golddigger_v1_api_proto_msgTypes[10]
+func (x *DeletePriceAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -544,14 +1881,21 @@ func (x *DeleteWatchlistItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteWatchlistItemRequest.ProtoReflect.Descriptor instead.
-func (*DeleteWatchlistItemRequest) Descriptor() ([]byte, []int) {
-	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DeletePriceAlertRequest.ProtoReflect.Descriptor instead.
+func (*DeletePriceAlertRequest) Descriptor() ([]byte, []int) {
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *DeleteWatchlistItemRequest) GetId() uint64 {
+func (x *DeletePriceAlertRequest) GetTickerId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.TickerId
+	}
+	return 0
+}
+
+func (x *DeletePriceAlertRequest) GetAlertId() uint64 {
+	if x != nil {
+		return x.AlertId
 	}
 	return 0
 }
@@ -565,7 +1909,7 @@ type OperationStatus struct {
 
 func (x *OperationStatus) Reset() {
 	*x = OperationStatus{}
-	mi := &file_proto_golddigger_v1_api_proto_msgTypes[11]
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -577,7 +1921,7 @@ func (x *OperationStatus) String() string {
 func (*OperationStatus) ProtoMessage() {}
 
 func (x *OperationStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_golddigger_v1_api_proto_msgTypes[11]
+	mi := &file_proto_golddigger_v1_api_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -590,7 +1934,7 @@ func (x *OperationStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperationStatus.ProtoReflect.Descriptor instead.
 func (*OperationStatus) Descriptor() ([]byte, []int) {
-	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{11}
+	return file_proto_golddigger_v1_api_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *OperationStatus) GetMessage() string {
@@ -610,13 +1954,87 @@ const file_proto_golddigger_v1_api_proto_rawDesc = "" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"\x12\n" +
 	"\x10GetHealthRequest\"J\n" +
 	"\x11GetHealthResponse\x125\n" +
-	"\x06health\x18\x01 \x01(\v2\x1d.golddigger.v1.HealthResponseR\x06health\"u\n" +
+	"\x06health\x18\x01 \x01(\v2\x1d.golddigger.v1.HealthResponseR\x06health\"\xd8\x01\n" +
 	"\vTickerPrice\x12\x16\n" +
 	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x14\n" +
 	"\x05price\x18\x02 \x01(\x01R\x05price\x128\n" +
-	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"/\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12/\n" +
+	"\x05as_of\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12\x18\n" +
+	"\asession\x18\x06 \x01(\tR\asession\"/\n" +
 	"\x15GetTickerPriceRequest\x12\x16\n" +
-	"\x06ticker\x18\x01 \x01(\tR\x06ticker\"\xc3\x01\n" +
+	"\x06ticker\x18\x01 \x01(\tR\x06ticker\"M\n" +
+	"\x19StreamTickerPricesRequest\x12\x18\n" +
+	"\atickers\x18\x01 \x03(\tR\atickers\x12\x16\n" +
+	"\x06replay\x18\x02 \x01(\x05R\x06replay\"\xae\x01\n" +
+	"\x1cGetTickerPriceHistoryRequest\x12\x16\n" +
+	"\x06ticker\x18\x01 \x01(\tR\x06ticker\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1a\n" +
+	"\binterval\x18\x04 \x01(\tR\binterval\"\xbf\x01\n" +
+	"\vPriceCandle\x122\n" +
+	"\x06bucket\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x06bucket\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\x01R\x04open\x12\x12\n" +
+	"\x04high\x18\x03 \x01(\x01R\x04high\x12\x10\n" +
+	"\x03low\x18\x04 \x01(\x01R\x03low\x12\x14\n" +
+	"\x05close\x18\x05 \x01(\x01R\x05close\x12\x16\n" +
+	"\x06volume\x18\x06 \x01(\x03R\x06volume\x12\x14\n" +
+	"\x05count\x18\a \x01(\x03R\x05count\"\xbd\x01\n" +
+	"\x1dGetTickerPriceHistoryResponse\x12\x16\n" +
+	"\x06ticker\x18\x01 \x01(\tR\x06ticker\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\tR\binterval\x122\n" +
+	"\x06prices\x18\x03 \x03(\v2\x1a.golddigger.v1.TickerPriceR\x06prices\x124\n" +
+	"\acandles\x18\x04 \x03(\v2\x1a.golddigger.v1.PriceCandleR\acandles\"\xc5\x02\n" +
+	"\x19GetIndicatorSeriesRequest\x12\x16\n" +
+	"\x06ticker\x18\x01 \x01(\tR\x06ticker\x12\x1c\n" +
+	"\tindicator\x18\x02 \x01(\tR\tindicator\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x16\n" +
+	"\x06period\x18\x05 \x01(\x05R\x06period\x12\x1f\n" +
+	"\vfast_period\x18\x06 \x01(\x05R\n" +
+	"fastPeriod\x12\x1f\n" +
+	"\vslow_period\x18\a \x01(\x05R\n" +
+	"slowPeriod\x12#\n" +
+	"\rsignal_period\x18\b \x01(\x05R\fsignalPeriod\x12\x17\n" +
+	"\astd_dev\x18\t \x01(\x01R\x06stdDev\"\xc8\x01\n" +
+	"\x0eIndicatorPoint\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12A\n" +
+	"\x06values\x18\x02 \x03(\v2).golddigger.v1.IndicatorPoint.ValuesEntryR\x06values\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"~\n" +
+	"\x0fIndicatorSeries\x12\x16\n" +
+	"\x06ticker\x18\x01 \x01(\tR\x06ticker\x12\x1c\n" +
+	"\tindicator\x18\x02 \x01(\tR\tindicator\x125\n" +
+	"\x06points\x18\x03 \x03(\v2\x1d.golddigger.v1.IndicatorPointR\x06points\"\xf0\x03\n" +
+	"\x06Signal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bstrategy\x18\x03 \x01(\tR\bstrategy\x12\x1c\n" +
+	"\tdirection\x18\x04 \x01(\tR\tdirection\x12\x1a\n" +
+	"\bstrength\x18\x05 \x01(\x01R\bstrength\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05price\x18\a \x01(\x01R\x05price\x12\x1d\n" +
+	"\n" +
+	"change_pct\x18\b \x01(\x01R\tchangePct\x12=\n" +
+	"\fwindow_start\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x12\x12\n" +
+	"\x04sent\x18\v \x01(\bR\x04sent\x12\x1d\n" +
+	"\n" +
+	"send_error\x18\f \x01(\tR\tsendError\x123\n" +
+	"\asent_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xba\x01\n" +
+	"\x12ListSignalsRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bstrategy\x18\x02 \x01(\tR\bstrategy\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"F\n" +
+	"\x13ListSignalsResponse\x12/\n" +
+	"\asignals\x18\x01 \x03(\v2\x15.golddigger.v1.SignalR\asignals\"\xb4\x02\n" +
 	"\rWatchlistItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
 	"\n" +
@@ -624,7 +2042,24 @@ const file_proto_golddigger_v1_api_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
 	"\x06symbol\x18\x04 \x01(\tR\x06symbol\x12\x14\n" +
-	"\x05notes\x18\x05 \x01(\tR\x05notes\"\x16\n" +
+	"\x05notes\x18\x05 \x01(\tR\x05notes\x120\n" +
+	"\x05alert\x18\x06 \x01(\v2\x1a.golddigger.v1.AlertConfigR\x05alert\x12\x1a\n" +
+	"\bexchange\x18\a \x01(\tR\bexchange\x12!\n" +
+	"\fsession_mode\x18\b \x01(\tR\vsessionMode\"\xc4\x02\n" +
+	"\vAlertConfig\x12\x1d\n" +
+	"\n" +
+	"min_change\x18\x01 \x01(\x01R\tminChange\x12\x1b\n" +
+	"\tmin_steps\x18\x02 \x01(\x05R\bminSteps\x12\x1f\n" +
+	"\vwindow_size\x18\x03 \x01(\x05R\n" +
+	"windowSize\x12)\n" +
+	"\x10cooldown_minutes\x18\x04 \x01(\x05R\x0fcooldownMinutes\x12\x1e\n" +
+	"\n" +
+	"strategies\x18\x05 \x03(\tR\n" +
+	"strategies\x12\x14\n" +
+	"\x05muted\x18\x06 \x01(\bR\x05muted\x12;\n" +
+	"\vmuted_until\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"mutedUntil\x12:\n" +
+	"\x19extended_hours_strategies\x18\b \x03(\tR\x17extendedHoursStrategies\"\x16\n" +
 	"\x14ListWatchlistRequest\"K\n" +
 	"\x15ListWatchlistResponse\x122\n" +
 	"\x05items\x18\x01 \x03(\v2\x1c.golddigger.v1.WatchlistItemR\x05items\"R\n" +
@@ -634,18 +2069,60 @@ const file_proto_golddigger_v1_api_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x124\n" +
 	"\x06ticker\x18\x02 \x01(\v2\x1c.golddigger.v1.WatchlistItemR\x06ticker\",\n" +
 	"\x1aDeleteWatchlistItemRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\"+\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\xca\x03\n" +
+	"\n" +
+	"PriceAlert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
+	"\tticker_id\x18\x02 \x01(\x04R\btickerId\x12\x16\n" +
+	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12\x1c\n" +
+	"\tcondition\x18\x04 \x01(\tR\tcondition\x12\x14\n" +
+	"\x05level\x18\x05 \x01(\x01R\x05level\x12'\n" +
+	"\x0freference_price\x18\x06 \x01(\x01R\x0ereferencePrice\x12\x16\n" +
+	"\x06repeat\x18\a \x01(\bR\x06repeat\x12\x14\n" +
+	"\x05armed\x18\b \x01(\bR\x05armed\x12#\n" +
+	"\rtrigger_count\x18\t \x01(\x05R\ftriggerCount\x12=\n" +
+	"\ftriggered_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vtriggeredAt\x12\x12\n" +
+	"\x04note\x18\v \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"5\n" +
+	"\x16ListPriceAlertsRequest\x12\x1b\n" +
+	"\tticker_id\x18\x01 \x01(\x04R\btickerId\"L\n" +
+	"\x17ListPriceAlertsResponse\x121\n" +
+	"\x06alerts\x18\x01 \x03(\v2\x19.golddigger.v1.PriceAlertR\x06alerts\"g\n" +
+	"\x17CreatePriceAlertRequest\x12\x1b\n" +
+	"\tticker_id\x18\x01 \x01(\x04R\btickerId\x12/\n" +
+	"\x05alert\x18\x02 \x01(\v2\x19.golddigger.v1.PriceAlertR\x05alert\"\x82\x01\n" +
+	"\x17UpdatePriceAlertRequest\x12\x1b\n" +
+	"\tticker_id\x18\x01 \x01(\x04R\btickerId\x12\x19\n" +
+	"\balert_id\x18\x02 \x01(\x04R\aalertId\x12/\n" +
+	"\x05alert\x18\x03 \x01(\v2\x19.golddigger.v1.PriceAlertR\x05alert\"Q\n" +
+	"\x17DeletePriceAlertRequest\x12\x1b\n" +
+	"\tticker_id\x18\x01 \x01(\x04R\btickerId\x12\x19\n" +
+	"\balert_id\x18\x02 \x01(\x04R\aalertId\"+\n" +
 	"\x0fOperationStatus\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage2w\n" +
 	"\rHealthService\x12f\n" +
-	"\tGetHealth\x12\x1f.golddigger.v1.GetHealthRequest\x1a .golddigger.v1.GetHealthResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/health2\x8f\x01\n" +
+	"\tGetHealth\x12\x1f.golddigger.v1.GetHealthRequest\x1a .golddigger.v1.GetHealthResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/health2\x91\x03\n" +
 	"\x12TickerPriceService\x12y\n" +
-	"\x0eGetTickerPrice\x12$.golddigger.v1.GetTickerPriceRequest\x1a\x1a.golddigger.v1.TickerPrice\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/ticker-price/{ticker}2\x94\x04\n" +
+	"\x0eGetTickerPrice\x12$.golddigger.v1.GetTickerPriceRequest\x1a\x1a.golddigger.v1.TickerPrice\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/ticker-price/{ticker}\x12\xa1\x01\n" +
+	"\x15GetTickerPriceHistory\x12+.golddigger.v1.GetTickerPriceHistoryRequest\x1a,.golddigger.v1.GetTickerPriceHistoryResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/ticker-price/{ticker}/history\x12\\\n" +
+	"\x12StreamTickerPrices\x12(.golddigger.v1.StreamTickerPricesRequest\x1a\x1a.golddigger.v1.TickerPrice0\x012\xa4\x01\n" +
+	"\x10IndicatorService\x12\x8f\x01\n" +
+	"\x12GetIndicatorSeries\x12(.golddigger.v1.GetIndicatorSeriesRequest\x1a\x1e.golddigger.v1.IndicatorSeries\"/\x82\xd3\xe4\x93\x02)\x12'/api/v1/indicators/{ticker}/{indicator}2~\n" +
+	"\rSignalService\x12m\n" +
+	"\vListSignals\x12!.golddigger.v1.ListSignalsRequest\x1a\".golddigger.v1.ListSignalsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/signals2\xd8\b\n" +
 	"\x10WatchlistService\x12u\n" +
 	"\rListWatchlist\x12#.golddigger.v1.ListWatchlistRequest\x1a$.golddigger.v1.ListWatchlistResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/watchlist\x12\x83\x01\n" +
 	"\x13CreateWatchlistItem\x12).golddigger.v1.CreateWatchlistItemRequest\x1a\x1e.golddigger.v1.OperationStatus\"!\x82\xd3\xe4\x93\x02\x1b:\x06ticker\"\x11/api/v1/watchlist\x12\x88\x01\n" +
 	"\x13UpdateWatchlistItem\x12).golddigger.v1.UpdateWatchlistItemRequest\x1a\x1e.golddigger.v1.OperationStatus\"&\x82\xd3\xe4\x93\x02 :\x06ticker\x1a\x16/api/v1/watchlist/{id}\x12x\n" +
-	"\x13DeleteWatchlistItem\x12).golddigger.v1.DeleteWatchlistItemRequest\x1a\x16.google.protobuf.Empty\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/watchlist/{id}BCZAgithub.com/khorzhenwin/gold-digger/gen/golddigger/v1;golddiggerv1b\x06proto3"
+	"\x13DeleteWatchlistItem\x12).golddigger.v1.DeleteWatchlistItemRequest\x1a\x16.google.protobuf.Empty\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/watchlist/{id}\x12\x8e\x01\n" +
+	"\x0fListPriceAlerts\x12%.golddigger.v1.ListPriceAlertsRequest\x1a&.golddigger.v1.ListPriceAlertsResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/watchlist/{ticker_id}/alerts\x12\x8a\x01\n" +
+	"\x10CreatePriceAlert\x12&.golddigger.v1.CreatePriceAlertRequest\x1a\x19.golddigger.v1.PriceAlert\"3\x82\xd3\xe4\x93\x02-:\x05alert\"$/api/v1/watchlist/{ticker_id}/alerts\x12\x95\x01\n" +
+	"\x10UpdatePriceAlert\x12&.golddigger.v1.UpdatePriceAlertRequest\x1a\x19.golddigger.v1.PriceAlert\">\x82\xd3\xe4\x93\x028:\x05alert\x1a//api/v1/watchlist/{ticker_id}/alerts/{alert_id}\x12\x8b\x01\n" +
+	"\x10DeletePriceAlert\x12&.golddigger.v1.DeletePriceAlertRequest\x1a\x16.google.protobuf.Empty\"7\x82\xd3\xe4\x93\x021*//api/v1/watchlist/{ticker_id}/alerts/{alert_id}BCZAgithub.com/khorzhenwin/gold-digger/gen/golddigger/v1;golddiggerv1b\x06proto3"
 
 var (
 	file_proto_golddigger_v1_api_proto_rawDescOnce sync.Once
@@ -659,48 +2136,108 @@ func file_proto_golddigger_v1_api_proto_rawDescGZIP() []byte {
 	return file_proto_golddigger_v1_api_proto_rawDescData
 }
 
-var file_proto_golddigger_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_proto_golddigger_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_proto_golddigger_v1_api_proto_goTypes = []any{
-	(*HealthResponse)(nil),             // 0: golddigger.v1.HealthResponse
-	(*GetHealthRequest)(nil),           // 1: golddigger.v1.GetHealthRequest
-	(*GetHealthResponse)(nil),          // 2: golddigger.v1.GetHealthResponse
-	(*TickerPrice)(nil),                // 3: golddigger.v1.TickerPrice
-	(*GetTickerPriceRequest)(nil),      // 4: golddigger.v1.GetTickerPriceRequest
-	(*WatchlistItem)(nil),              // 5: golddigger.v1.WatchlistItem
-	(*ListWatchlistRequest)(nil),       // 6: golddigger.v1.ListWatchlistRequest
-	(*ListWatchlistResponse)(nil),      // 7: golddigger.v1.ListWatchlistResponse
-	(*CreateWatchlistItemRequest)(nil), // 8: golddigger.v1.CreateWatchlistItemRequest
-	(*UpdateWatchlistItemRequest)(nil), // 9: golddigger.v1.UpdateWatchlistItemRequest
-	(*DeleteWatchlistItemRequest)(nil), // 10: golddigger.v1.DeleteWatchlistItemRequest
-	(*OperationStatus)(nil),            // 11: golddigger.v1.OperationStatus
-	(*timestamppb.Timestamp)(nil),      // 12: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),              // 13: google.protobuf.Empty
+	(*HealthResponse)(nil),                // 0: golddigger.v1.HealthResponse
+	(*GetHealthRequest)(nil),              // 1: golddigger.v1.GetHealthRequest
+	(*GetHealthResponse)(nil),             // 2: golddigger.v1.GetHealthResponse
+	(*TickerPrice)(nil),                   // 3: golddigger.v1.TickerPrice
+	(*GetTickerPriceRequest)(nil),         // 4: golddigger.v1.GetTickerPriceRequest
+	(*StreamTickerPricesRequest)(nil),     // 5: golddigger.v1.StreamTickerPricesRequest
+	(*GetTickerPriceHistoryRequest)(nil),  // 6: golddigger.v1.GetTickerPriceHistoryRequest
+	(*PriceCandle)(nil),                   // 7: golddigger.v1.PriceCandle
+	(*GetTickerPriceHistoryResponse)(nil), // 8: golddigger.v1.GetTickerPriceHistoryResponse
+	(*GetIndicatorSeriesRequest)(nil),     // 9: golddigger.v1.GetIndicatorSeriesRequest
+	(*IndicatorPoint)(nil),                // 10: golddigger.v1.IndicatorPoint
+	(*IndicatorSeries)(nil),               // 11: golddigger.v1.IndicatorSeries
+	(*Signal)(nil),                        // 12: golddigger.v1.Signal
+	(*ListSignalsRequest)(nil),            // 13: golddigger.v1.ListSignalsRequest
+	(*ListSignalsResponse)(nil),           // 14: golddigger.v1.ListSignalsResponse
+	(*WatchlistItem)(nil),                 // 15: golddigger.v1.WatchlistItem
+	(*AlertConfig)(nil),                   // 16: golddigger.v1.AlertConfig
+	(*ListWatchlistRequest)(nil),          // 17: golddigger.v1.ListWatchlistRequest
+	(*ListWatchlistResponse)(nil),         // 18: golddigger.v1.ListWatchlistResponse
+	(*CreateWatchlistItemRequest)(nil),    // 19: golddigger.v1.CreateWatchlistItemRequest
+	(*UpdateWatchlistItemRequest)(nil),    // 20: golddigger.v1.UpdateWatchlistItemRequest
+	(*DeleteWatchlistItemRequest)(nil),    // 21: golddigger.v1.DeleteWatchlistItemRequest
+	(*PriceAlert)(nil),                    // 22: golddigger.v1.PriceAlert
+	(*ListPriceAlertsRequest)(nil),        // 23: golddigger.v1.ListPriceAlertsRequest
+	(*ListPriceAlertsResponse)(nil),       // 24: golddigger.v1.ListPriceAlertsResponse
+	(*CreatePriceAlertRequest)(nil),       // 25: golddigger.v1.CreatePriceAlertRequest
+	(*UpdatePriceAlertRequest)(nil),       // 26: golddigger.v1.UpdatePriceAlertRequest
+	(*DeletePriceAlertRequest)(nil),       // 27: golddigger.v1.DeletePriceAlertRequest
+	(*OperationStatus)(nil),               // 28: golddigger.v1.OperationStatus
+	nil,                                   // 29: golddigger.v1.IndicatorPoint.ValuesEntry
+	(*timestamppb.Timestamp)(nil),         // 30: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                 // 31: google.protobuf.Empty
 }
 var file_proto_golddigger_v1_api_proto_depIdxs = []int32{
 	0,  // 0: golddigger.v1.GetHealthResponse.health:type_name -> golddigger.v1.HealthResponse
-	12, // 1: golddigger.v1.TickerPrice.timestamp:type_name -> google.protobuf.Timestamp
-	12, // 2: golddigger.v1.WatchlistItem.created_at:type_name -> google.protobuf.Timestamp
-	12, // 3: golddigger.v1.WatchlistItem.updated_at:type_name -> google.protobuf.Timestamp
-	5,  // 4: golddigger.v1.ListWatchlistResponse.items:type_name -> golddigger.v1.WatchlistItem
-	5,  // 5: golddigger.v1.CreateWatchlistItemRequest.ticker:type_name -> golddigger.v1.WatchlistItem
-	5,  // 6: golddigger.v1.UpdateWatchlistItemRequest.ticker:type_name -> golddigger.v1.WatchlistItem
-	1,  // 7: golddigger.v1.HealthService.GetHealth:input_type -> golddigger.v1.GetHealthRequest
-	4,  // 8: golddigger.v1.TickerPriceService.GetTickerPrice:input_type -> golddigger.v1.GetTickerPriceRequest
-	6,  // 9: golddigger.v1.WatchlistService.ListWatchlist:input_type -> golddigger.v1.ListWatchlistRequest
-	8,  // 10: golddigger.v1.WatchlistService.CreateWatchlistItem:input_type -> golddigger.v1.CreateWatchlistItemRequest
-	9,  // 11: golddigger.v1.WatchlistService.UpdateWatchlistItem:input_type -> golddigger.v1.UpdateWatchlistItemRequest
-	10, // 12: golddigger.v1.WatchlistService.DeleteWatchlistItem:input_type -> golddigger.v1.DeleteWatchlistItemRequest
-	2,  // 13: golddigger.v1.HealthService.GetHealth:output_type -> golddigger.v1.GetHealthResponse
-	3,  // 14: golddigger.v1.TickerPriceService.GetTickerPrice:output_type -> golddigger.v1.TickerPrice
-	7,  // 15: golddigger.v1.WatchlistService.ListWatchlist:output_type -> golddigger.v1.ListWatchlistResponse
-	11, // 16: golddigger.v1.WatchlistService.CreateWatchlistItem:output_type -> golddigger.v1.OperationStatus
-	11, // 17: golddigger.v1.WatchlistService.UpdateWatchlistItem:output_type -> golddigger.v1.OperationStatus
-	13, // 18: golddigger.v1.WatchlistService.DeleteWatchlistItem:output_type -> google.protobuf.Empty
-	13, // [13:19] is the sub-list for method output_type
-	7,  // [7:13] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	30, // 1: golddigger.v1.TickerPrice.timestamp:type_name -> google.protobuf.Timestamp
+	30, // 2: golddigger.v1.TickerPrice.as_of:type_name -> google.protobuf.Timestamp
+	30, // 3: golddigger.v1.GetTickerPriceHistoryRequest.from:type_name -> google.protobuf.Timestamp
+	30, // 4: golddigger.v1.GetTickerPriceHistoryRequest.to:type_name -> google.protobuf.Timestamp
+	30, // 5: golddigger.v1.PriceCandle.bucket:type_name -> google.protobuf.Timestamp
+	3,  // 6: golddigger.v1.GetTickerPriceHistoryResponse.prices:type_name -> golddigger.v1.TickerPrice
+	7,  // 7: golddigger.v1.GetTickerPriceHistoryResponse.candles:type_name -> golddigger.v1.PriceCandle
+	30, // 8: golddigger.v1.GetIndicatorSeriesRequest.from:type_name -> google.protobuf.Timestamp
+	30, // 9: golddigger.v1.GetIndicatorSeriesRequest.to:type_name -> google.protobuf.Timestamp
+	30, // 10: golddigger.v1.IndicatorPoint.timestamp:type_name -> google.protobuf.Timestamp
+	29, // 11: golddigger.v1.IndicatorPoint.values:type_name -> golddigger.v1.IndicatorPoint.ValuesEntry
+	10, // 12: golddigger.v1.IndicatorSeries.points:type_name -> golddigger.v1.IndicatorPoint
+	30, // 13: golddigger.v1.Signal.window_start:type_name -> google.protobuf.Timestamp
+	30, // 14: golddigger.v1.Signal.window_end:type_name -> google.protobuf.Timestamp
+	30, // 15: golddigger.v1.Signal.sent_at:type_name -> google.protobuf.Timestamp
+	30, // 16: golddigger.v1.Signal.created_at:type_name -> google.protobuf.Timestamp
+	30, // 17: golddigger.v1.ListSignalsRequest.from:type_name -> google.protobuf.Timestamp
+	30, // 18: golddigger.v1.ListSignalsRequest.to:type_name -> google.protobuf.Timestamp
+	12, // 19: golddigger.v1.ListSignalsResponse.signals:type_name -> golddigger.v1.Signal
+	30, // 20: golddigger.v1.WatchlistItem.created_at:type_name -> google.protobuf.Timestamp
+	30, // 21: golddigger.v1.WatchlistItem.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 22: golddigger.v1.WatchlistItem.alert:type_name -> golddigger.v1.AlertConfig
+	30, // 23: golddigger.v1.AlertConfig.muted_until:type_name -> google.protobuf.Timestamp
+	15, // 24: golddigger.v1.ListWatchlistResponse.items:type_name -> golddigger.v1.WatchlistItem
+	15, // 25: golddigger.v1.CreateWatchlistItemRequest.ticker:type_name -> golddigger.v1.WatchlistItem
+	15, // 26: golddigger.v1.UpdateWatchlistItemRequest.ticker:type_name -> golddigger.v1.WatchlistItem
+	30, // 27: golddigger.v1.PriceAlert.triggered_at:type_name -> google.protobuf.Timestamp
+	30, // 28: golddigger.v1.PriceAlert.created_at:type_name -> google.protobuf.Timestamp
+	30, // 29: golddigger.v1.PriceAlert.updated_at:type_name -> google.protobuf.Timestamp
+	22, // 30: golddigger.v1.ListPriceAlertsResponse.alerts:type_name -> golddigger.v1.PriceAlert
+	22, // 31: golddigger.v1.CreatePriceAlertRequest.alert:type_name -> golddigger.v1.PriceAlert
+	22, // 32: golddigger.v1.UpdatePriceAlertRequest.alert:type_name -> golddigger.v1.PriceAlert
+	1,  // 33: golddigger.v1.HealthService.GetHealth:input_type -> golddigger.v1.GetHealthRequest
+	4,  // 34: golddigger.v1.TickerPriceService.GetTickerPrice:input_type -> golddigger.v1.GetTickerPriceRequest
+	6,  // 35: golddigger.v1.TickerPriceService.GetTickerPriceHistory:input_type -> golddigger.v1.GetTickerPriceHistoryRequest
+	5,  // 36: golddigger.v1.TickerPriceService.StreamTickerPrices:input_type -> golddigger.v1.StreamTickerPricesRequest
+	9,  // 37: golddigger.v1.IndicatorService.GetIndicatorSeries:input_type -> golddigger.v1.GetIndicatorSeriesRequest
+	13, // 38: golddigger.v1.SignalService.ListSignals:input_type -> golddigger.v1.ListSignalsRequest
+	17, // 39: golddigger.v1.WatchlistService.ListWatchlist:input_type -> golddigger.v1.ListWatchlistRequest
+	19, // 40: golddigger.v1.WatchlistService.CreateWatchlistItem:input_type -> golddigger.v1.CreateWatchlistItemRequest
+	20, // 41: golddigger.v1.WatchlistService.UpdateWatchlistItem:input_type -> golddigger.v1.UpdateWatchlistItemRequest
+	21, // 42: golddigger.v1.WatchlistService.DeleteWatchlistItem:input_type -> golddigger.v1.DeleteWatchlistItemRequest
+	23, // 43: golddigger.v1.WatchlistService.ListPriceAlerts:input_type -> golddigger.v1.ListPriceAlertsRequest
+	25, // 44: golddigger.v1.WatchlistService.CreatePriceAlert:input_type -> golddigger.v1.CreatePriceAlertRequest
+	26, // 45: golddigger.v1.WatchlistService.UpdatePriceAlert:input_type -> golddigger.v1.UpdatePriceAlertRequest
+	27, // 46: golddigger.v1.WatchlistService.DeletePriceAlert:input_type -> golddigger.v1.DeletePriceAlertRequest
+	2,  // 47: golddigger.v1.HealthService.GetHealth:output_type -> golddigger.v1.GetHealthResponse
+	3,  // 48: golddigger.v1.TickerPriceService.GetTickerPrice:output_type -> golddigger.v1.TickerPrice
+	8,  // 49: golddigger.v1.TickerPriceService.GetTickerPriceHistory:output_type -> golddigger.v1.GetTickerPriceHistoryResponse
+	3,  // 50: golddigger.v1.TickerPriceService.StreamTickerPrices:output_type -> golddigger.v1.TickerPrice
+	11, // 51: golddigger.v1.IndicatorService.GetIndicatorSeries:output_type -> golddigger.v1.IndicatorSeries
+	14, // 52: golddigger.v1.SignalService.ListSignals:output_type -> golddigger.v1.ListSignalsResponse
+	18, // 53: golddigger.v1.WatchlistService.ListWatchlist:output_type -> golddigger.v1.ListWatchlistResponse
+	28, // 54: golddigger.v1.WatchlistService.CreateWatchlistItem:output_type -> golddigger.v1.OperationStatus
+	28, // 55: golddigger.v1.WatchlistService.UpdateWatchlistItem:output_type -> golddigger.v1.OperationStatus
+	31, // 56: golddigger.v1.WatchlistService.DeleteWatchlistItem:output_type -> google.protobuf.Empty
+	24, // 57: golddigger.v1.WatchlistService.ListPriceAlerts:output_type -> golddigger.v1.ListPriceAlertsResponse
+	22, // 58: golddigger.v1.WatchlistService.CreatePriceAlert:output_type -> golddigger.v1.PriceAlert
+	22, // 59: golddigger.v1.WatchlistService.UpdatePriceAlert:output_type -> golddigger.v1.PriceAlert
+	31, // 60: golddigger.v1.WatchlistService.DeletePriceAlert:output_type -> google.protobuf.Empty
+	47, // [47:61] is the sub-list for method output_type
+	33, // [33:47] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_proto_golddigger_v1_api_proto_init() }
@@ -714,9 +2251,9 @@ func file_proto_golddigger_v1_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_golddigger_v1_api_proto_rawDesc), len(file_proto_golddigger_v1_api_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   30,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   5,
 		},
 		GoTypes:           file_proto_golddigger_v1_api_proto_goTypes,
 		DependencyIndexes: file_proto_golddigger_v1_api_proto_depIdxs,
@@ -122,7 +122,9 @@ var HealthService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	TickerPriceService_GetTickerPrice_FullMethodName = "/golddigger.v1.TickerPriceService/GetTickerPrice"
+	TickerPriceService_GetTickerPrice_FullMethodName        = "/golddigger.v1.TickerPriceService/GetTickerPrice"
+	TickerPriceService_GetTickerPriceHistory_FullMethodName = "/golddigger.v1.TickerPriceService/GetTickerPriceHistory"
+	TickerPriceService_StreamTickerPrices_FullMethodName    = "/golddigger.v1.TickerPriceService/StreamTickerPrices"
 )
 
 // TickerPriceServiceClient is the client API for TickerPriceService service.
@@ -130,6 +132,9 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TickerPriceServiceClient interface {
 	GetTickerPrice(ctx context.Context, in *GetTickerPriceRequest, opts ...grpc.CallOption) (*TickerPrice, error)
+	GetTickerPriceHistory(ctx context.Context, in *GetTickerPriceHistoryRequest, opts ...grpc.CallOption) (*GetTickerPriceHistoryResponse, error)
+	// Pushes every newly polled price for the requested tickers until the client disconnects.
+	StreamTickerPrices(ctx context.Context, in *StreamTickerPricesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TickerPrice], error)
 }
 
 type tickerPriceServiceClient struct {
@@ -150,11 +155,43 @@ func (c *tickerPriceServiceClient) GetTickerPrice(ctx context.Context, in *GetTi
 	return out, nil
 }
 
+func (c *tickerPriceServiceClient) GetTickerPriceHistory(ctx context.Context, in *GetTickerPriceHistoryRequest, opts ...grpc.CallOption) (*GetTickerPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTickerPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, TickerPriceService_GetTickerPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tickerPriceServiceClient) StreamTickerPrices(ctx context.Context, in *StreamTickerPricesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TickerPrice], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TickerPriceService_ServiceDesc.Streams[0], TickerPriceService_StreamTickerPrices_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamTickerPricesRequest, TickerPrice]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TickerPriceService_StreamTickerPricesClient = grpc.ServerStreamingClient[TickerPrice]
+
 // TickerPriceServiceServer is the server API for TickerPriceService service.
 // All implementations must embed UnimplementedTickerPriceServiceServer
 // for forward compatibility.
 type TickerPriceServiceServer interface {
 	GetTickerPrice(context.Context, *GetTickerPriceRequest) (*TickerPrice, error)
+	GetTickerPriceHistory(context.Context, *GetTickerPriceHistoryRequest) (*GetTickerPriceHistoryResponse, error)
+	// Pushes every newly polled price for the requested tickers until the client disconnects.
+	StreamTickerPrices(*StreamTickerPricesRequest, grpc.ServerStreamingServer[TickerPrice]) error
 	mustEmbedUnimplementedTickerPriceServiceServer()
 }
 
@@ -168,6 +205,12 @@ type UnimplementedTickerPriceServiceServer struct{}
 func (UnimplementedTickerPriceServiceServer) GetTickerPrice(context.Context, *GetTickerPriceRequest) (*TickerPrice, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTickerPrice not implemented")
 }
+func (UnimplementedTickerPriceServiceServer) GetTickerPriceHistory(context.Context, *GetTickerPriceHistoryRequest) (*GetTickerPriceHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTickerPriceHistory not implemented")
+}
+func (UnimplementedTickerPriceServiceServer) StreamTickerPrices(*StreamTickerPricesRequest, grpc.ServerStreamingServer[TickerPrice]) error {
+	return status.Error(codes.Unimplemented, "method StreamTickerPrices not implemented")
+}
 func (UnimplementedTickerPriceServiceServer) mustEmbedUnimplementedTickerPriceServiceServer() {}
 func (UnimplementedTickerPriceServiceServer) testEmbeddedByValue()                            {}
 
@@ -207,6 +250,35 @@ func _TickerPriceService_GetTickerPrice_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TickerPriceService_GetTickerPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickerPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TickerPriceServiceServer).GetTickerPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TickerPriceService_GetTickerPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TickerPriceServiceServer).GetTickerPriceHistory(ctx, req.(*GetTickerPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TickerPriceService_StreamTickerPrices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTickerPricesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TickerPriceServiceServer).StreamTickerPrices(m, &grpc.GenericServerStream[StreamTickerPricesRequest, TickerPrice]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TickerPriceService_StreamTickerPricesServer = grpc.ServerStreamingServer[TickerPrice]
+
 // TickerPriceService_ServiceDesc is the grpc.ServiceDesc for TickerPriceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -218,6 +290,220 @@ var TickerPriceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTickerPrice",
 			Handler:    _TickerPriceService_GetTickerPrice_Handler,
 		},
+		{
+			MethodName: "GetTickerPriceHistory",
+			Handler:    _TickerPriceService_GetTickerPriceHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamTickerPrices",
+			Handler:       _TickerPriceService_StreamTickerPrices_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/golddigger/v1/api.proto",
+}
+
+const (
+	IndicatorService_GetIndicatorSeries_FullMethodName = "/golddigger.v1.IndicatorService/GetIndicatorSeries"
+)
+
+// IndicatorServiceClient is the client API for IndicatorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type IndicatorServiceClient interface {
+	GetIndicatorSeries(ctx context.Context, in *GetIndicatorSeriesRequest, opts ...grpc.CallOption) (*IndicatorSeries, error)
+}
+
+type indicatorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIndicatorServiceClient(cc grpc.ClientConnInterface) IndicatorServiceClient {
+	return &indicatorServiceClient{cc}
+}
+
+func (c *indicatorServiceClient) GetIndicatorSeries(ctx context.Context, in *GetIndicatorSeriesRequest, opts ...grpc.CallOption) (*IndicatorSeries, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IndicatorSeries)
+	err := c.cc.Invoke(ctx, IndicatorService_GetIndicatorSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IndicatorServiceServer is the server API for IndicatorService service.
+// All implementations must embed UnimplementedIndicatorServiceServer
+// for forward compatibility.
+type IndicatorServiceServer interface {
+	GetIndicatorSeries(context.Context, *GetIndicatorSeriesRequest) (*IndicatorSeries, error)
+	mustEmbedUnimplementedIndicatorServiceServer()
+}
+
+// UnimplementedIndicatorServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedIndicatorServiceServer struct{}
+
+func (UnimplementedIndicatorServiceServer) GetIndicatorSeries(context.Context, *GetIndicatorSeriesRequest) (*IndicatorSeries, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetIndicatorSeries not implemented")
+}
+func (UnimplementedIndicatorServiceServer) mustEmbedUnimplementedIndicatorServiceServer() {}
+func (UnimplementedIndicatorServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeIndicatorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IndicatorServiceServer will
+// result in compilation errors.
+type UnsafeIndicatorServiceServer interface {
+	mustEmbedUnimplementedIndicatorServiceServer()
+}
+
+func RegisterIndicatorServiceServer(s grpc.ServiceRegistrar, srv IndicatorServiceServer) {
+	// If the following call panics, it indicates UnimplementedIndicatorServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&IndicatorService_ServiceDesc, srv)
+}
+
+func _IndicatorService_GetIndicatorSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndicatorSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndicatorServiceServer).GetIndicatorSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IndicatorService_GetIndicatorSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndicatorServiceServer).GetIndicatorSeries(ctx, req.(*GetIndicatorSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IndicatorService_ServiceDesc is the grpc.ServiceDesc for IndicatorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IndicatorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "golddigger.v1.IndicatorService",
+	HandlerType: (*IndicatorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetIndicatorSeries",
+			Handler:    _IndicatorService_GetIndicatorSeries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/golddigger/v1/api.proto",
+}
+
+const (
+	SignalService_ListSignals_FullMethodName = "/golddigger.v1.SignalService/ListSignals"
+)
+
+// SignalServiceClient is the client API for SignalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SignalServiceClient interface {
+	ListSignals(ctx context.Context, in *ListSignalsRequest, opts ...grpc.CallOption) (*ListSignalsResponse, error)
+}
+
+type signalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSignalServiceClient(cc grpc.ClientConnInterface) SignalServiceClient {
+	return &signalServiceClient{cc}
+}
+
+func (c *signalServiceClient) ListSignals(ctx context.Context, in *ListSignalsRequest, opts ...grpc.CallOption) (*ListSignalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSignalsResponse)
+	err := c.cc.Invoke(ctx, SignalService_ListSignals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SignalServiceServer is the server API for SignalService service.
+// All implementations must embed UnimplementedSignalServiceServer
+// for forward compatibility.
+type SignalServiceServer interface {
+	ListSignals(context.Context, *ListSignalsRequest) (*ListSignalsResponse, error)
+	mustEmbedUnimplementedSignalServiceServer()
+}
+
+// UnimplementedSignalServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSignalServiceServer struct{}
+
+func (UnimplementedSignalServiceServer) ListSignals(context.Context, *ListSignalsRequest) (*ListSignalsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSignals not implemented")
+}
+func (UnimplementedSignalServiceServer) mustEmbedUnimplementedSignalServiceServer() {}
+func (UnimplementedSignalServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeSignalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SignalServiceServer will
+// result in compilation errors.
+type UnsafeSignalServiceServer interface {
+	mustEmbedUnimplementedSignalServiceServer()
+}
+
+func RegisterSignalServiceServer(s grpc.ServiceRegistrar, srv SignalServiceServer) {
+	// If the following call panics, it indicates UnimplementedSignalServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SignalService_ServiceDesc, srv)
+}
+
+func _SignalService_ListSignals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSignalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignalServiceServer).ListSignals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignalService_ListSignals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignalServiceServer).ListSignals(ctx, req.(*ListSignalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SignalService_ServiceDesc is the grpc.ServiceDesc for SignalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SignalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "golddigger.v1.SignalService",
+	HandlerType: (*SignalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSignals",
+			Handler:    _SignalService_ListSignals_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/golddigger/v1/api.proto",
@@ -228,6 +514,10 @@ const (
 	WatchlistService_CreateWatchlistItem_FullMethodName = "/golddigger.v1.WatchlistService/CreateWatchlistItem"
 	WatchlistService_UpdateWatchlistItem_FullMethodName = "/golddigger.v1.WatchlistService/UpdateWatchlistItem"
 	WatchlistService_DeleteWatchlistItem_FullMethodName = "/golddigger.v1.WatchlistService/DeleteWatchlistItem"
+	WatchlistService_ListPriceAlerts_FullMethodName     = "/golddigger.v1.WatchlistService/ListPriceAlerts"
+	WatchlistService_CreatePriceAlert_FullMethodName    = "/golddigger.v1.WatchlistService/CreatePriceAlert"
+	WatchlistService_UpdatePriceAlert_FullMethodName    = "/golddigger.v1.WatchlistService/UpdatePriceAlert"
+	WatchlistService_DeletePriceAlert_FullMethodName    = "/golddigger.v1.WatchlistService/DeletePriceAlert"
 )
 
 // WatchlistServiceClient is the client API for WatchlistService service.
@@ -238,6 +528,10 @@ type WatchlistServiceClient interface {
 	CreateWatchlistItem(ctx context.Context, in *CreateWatchlistItemRequest, opts ...grpc.CallOption) (*OperationStatus, error)
 	UpdateWatchlistItem(ctx context.Context, in *UpdateWatchlistItemRequest, opts ...grpc.CallOption) (*OperationStatus, error)
 	DeleteWatchlistItem(ctx context.Context, in *DeleteWatchlistItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListPriceAlerts(ctx context.Context, in *ListPriceAlertsRequest, opts ...grpc.CallOption) (*ListPriceAlertsResponse, error)
+	CreatePriceAlert(ctx context.Context, in *CreatePriceAlertRequest, opts ...grpc.CallOption) (*PriceAlert, error)
+	UpdatePriceAlert(ctx context.Context, in *UpdatePriceAlertRequest, opts ...grpc.CallOption) (*PriceAlert, error)
+	DeletePriceAlert(ctx context.Context, in *DeletePriceAlertRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type watchlistServiceClient struct {
@@ -288,6 +582,46 @@ func (c *watchlistServiceClient) DeleteWatchlistItem(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *watchlistServiceClient) ListPriceAlerts(ctx context.Context, in *ListPriceAlertsRequest, opts ...grpc.CallOption) (*ListPriceAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPriceAlertsResponse)
+	err := c.cc.Invoke(ctx, WatchlistService_ListPriceAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchlistServiceClient) CreatePriceAlert(ctx context.Context, in *CreatePriceAlertRequest, opts ...grpc.CallOption) (*PriceAlert, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceAlert)
+	err := c.cc.Invoke(ctx, WatchlistService_CreatePriceAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchlistServiceClient) UpdatePriceAlert(ctx context.Context, in *UpdatePriceAlertRequest, opts ...grpc.CallOption) (*PriceAlert, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceAlert)
+	err := c.cc.Invoke(ctx, WatchlistService_UpdatePriceAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchlistServiceClient) DeletePriceAlert(ctx context.Context, in *DeletePriceAlertRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WatchlistService_DeletePriceAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WatchlistServiceServer is the server API for WatchlistService service.
 // All implementations must embed UnimplementedWatchlistServiceServer
 // for forward compatibility.
@@ -296,6 +630,10 @@ type WatchlistServiceServer interface {
 	CreateWatchlistItem(context.Context, *CreateWatchlistItemRequest) (*OperationStatus, error)
 	UpdateWatchlistItem(context.Context, *UpdateWatchlistItemRequest) (*OperationStatus, error)
 	DeleteWatchlistItem(context.Context, *DeleteWatchlistItemRequest) (*emptypb.Empty, error)
+	ListPriceAlerts(context.Context, *ListPriceAlertsRequest) (*ListPriceAlertsResponse, error)
+	CreatePriceAlert(context.Context, *CreatePriceAlertRequest) (*PriceAlert, error)
+	UpdatePriceAlert(context.Context, *UpdatePriceAlertRequest) (*PriceAlert, error)
+	DeletePriceAlert(context.Context, *DeletePriceAlertRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedWatchlistServiceServer()
 }
 
@@ -318,6 +656,18 @@ func (UnimplementedWatchlistServiceServer) UpdateWatchlistItem(context.Context,
 func (UnimplementedWatchlistServiceServer) DeleteWatchlistItem(context.Context, *DeleteWatchlistItemRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteWatchlistItem not implemented")
 }
+func (UnimplementedWatchlistServiceServer) ListPriceAlerts(context.Context, *ListPriceAlertsRequest) (*ListPriceAlertsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPriceAlerts not implemented")
+}
+func (UnimplementedWatchlistServiceServer) CreatePriceAlert(context.Context, *CreatePriceAlertRequest) (*PriceAlert, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePriceAlert not implemented")
+}
+func (UnimplementedWatchlistServiceServer) UpdatePriceAlert(context.Context, *UpdatePriceAlertRequest) (*PriceAlert, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePriceAlert not implemented")
+}
+func (UnimplementedWatchlistServiceServer) DeletePriceAlert(context.Context, *DeletePriceAlertRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePriceAlert not implemented")
+}
 func (UnimplementedWatchlistServiceServer) mustEmbedUnimplementedWatchlistServiceServer() {}
 func (UnimplementedWatchlistServiceServer) testEmbeddedByValue()                          {}
 
@@ -411,6 +761,78 @@ func _WatchlistService_DeleteWatchlistItem_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WatchlistService_ListPriceAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriceAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).ListPriceAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_ListPriceAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).ListPriceAlerts(ctx, req.(*ListPriceAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchlistService_CreatePriceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePriceAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).CreatePriceAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_CreatePriceAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).CreatePriceAlert(ctx, req.(*CreatePriceAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchlistService_UpdatePriceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePriceAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).UpdatePriceAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_UpdatePriceAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).UpdatePriceAlert(ctx, req.(*UpdatePriceAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchlistService_DeletePriceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePriceAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).DeletePriceAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_DeletePriceAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).DeletePriceAlert(ctx, req.(*DeletePriceAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WatchlistService_ServiceDesc is the grpc.ServiceDesc for WatchlistService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -434,6 +856,22 @@ var WatchlistService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteWatchlistItem",
 			Handler:    _WatchlistService_DeleteWatchlistItem_Handler,
 		},
+		{
+			MethodName: "ListPriceAlerts",
+			Handler:    _WatchlistService_ListPriceAlerts_Handler,
+		},
+		{
+			MethodName: "CreatePriceAlert",
+			Handler:    _WatchlistService_CreatePriceAlert_Handler,
+		},
+		{
+			MethodName: "UpdatePriceAlert",
+			Handler:    _WatchlistService_UpdatePriceAlert_Handler,
+		},
+		{
+			MethodName: "DeletePriceAlert",
+			Handler:    _WatchlistService_DeletePriceAlert_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/golddigger/v1/api.proto",
@@ -0,0 +1,240 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-chi/chi/v5"
+	"github.com/khorzhenwin/gold-digger/internal/market-data"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"github.com/khorzhenwin/gold-digger/internal/notification"
+	"net/http"
+	"strconv"
+)
+
+const (
+	defaultNotificationLimit = 100
+	maxNotificationLimit     = 1000
+)
+
+type Handler struct {
+	Provider market_data.QuoteProvider
+	Outbox   *notification.Outbox
+}
+
+type ResendResponse struct {
+	Requeued int64 `json:"requeued"`
+}
+
+// PreviewRequest renders Template, or the stored template of Kind when empty, against Data or
+// the sample data of Kind. Format defaults to the format of Channel when it is registered.
+type PreviewRequest struct {
+	Kind     string                    `json:"kind"`
+	Channel  string                    `json:"channel"`
+	Format   *string                   `json:"format,omitempty"`
+	Template string                    `json:"template,omitempty"`
+	Data     *notification.MessageData `json:"data,omitempty"`
+}
+
+type PreviewResponse struct {
+	Kind    string `json:"kind"`
+	Channel string `json:"channel"`
+	Format  string `json:"format"`
+	Message string `json:"message"`
+}
+
+type ProviderKeysResponse struct {
+	Provider string                  `json:"provider"`
+	Keys     []market_data.KeyHealth `json:"keys"`
+}
+
+func RegisterRoutes(r chi.Router, provider market_data.QuoteProvider, outbox *notification.Outbox) {
+	h := &Handler{Provider: provider, Outbox: outbox}
+
+	r.Route("/admin", func(r chi.Router) {
+		r.Get("/provider/keys", h.GetProviderKeysHandler)
+		r.Get("/notifications", h.GetNotificationsHandler)
+		r.Post("/notifications/resend", h.ResendDeadNotificationsHandler)
+		r.Post("/notifications/{id}/resend", h.ResendNotificationHandler)
+		r.Post("/notifications/preview", h.PreviewNotificationHandler)
+	})
+}
+
+// GetProviderKeysHandler handles GET /admin/provider/keys
+// @Summary      Get market data API key health
+// @Description  Returns per-key quota usage and whether each key is parked, keys are masked
+// @Tags         admin
+// @Produce      json
+// @Success      200  {object}  ProviderKeysResponse
+// @Router       /api/v1/admin/provider/keys [get]
+func (h *Handler) GetProviderKeysHandler(w http.ResponseWriter, r *http.Request) {
+	response := ProviderKeysResponse{Provider: h.Provider.Name(), Keys: []market_data.KeyHealth{}}
+	if reporter, ok := h.Provider.(market_data.KeyHealthReporter); ok {
+		response.Keys = reporter.KeyHealth()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return
+	}
+}
+
+// GetNotificationsHandler handles GET /admin/notifications
+// @Summary      List queued notifications
+// @Description  Returns notification outbox deliveries newest first, one per channel, with attempts and the last error
+// @Tags         admin
+// @Produce      json
+// @Param        status  query     string  false  "pending, sent, dead or digested"
+// @Param        limit   query     int     false  "Max deliveries returned, 100 by default and at most 1000"
+// @Success      200     {array}   models.OutboxMessage
+// @Failure      400     {string}  string  "bad request"
+// @Router       /api/v1/admin/notifications [get]
+func (h *Handler) GetNotificationsHandler(w http.ResponseWriter, r *http.Request) {
+	status := r.URL.Query().Get("status")
+	switch status {
+	case "", models.OutboxPending, models.OutboxSent, models.OutboxDead, models.OutboxDigested:
+	default:
+		http.Error(w, "invalid 'status', expected pending, sent, dead or digested", http.StatusBadRequest)
+		return
+	}
+
+	limit := defaultNotificationLimit
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			http.Error(w, fmt.Sprintf("invalid 'limit' %q", value), http.StatusBadRequest)
+			return
+		}
+		limit = min(parsed, maxNotificationLimit)
+	}
+
+	messages, err := h.Outbox.Find(status, limit)
+	if err != nil {
+		http.Error(w, "Failed to retrieve notifications", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(messages)
+	if err != nil {
+		return
+	}
+}
+
+// ResendNotificationHandler handles POST /admin/notifications/{id}/resend
+// @Summary      Resend a dead-lettered notification
+// @Description  Requeues a delivery that ran out of attempts with a fresh set of attempts
+// @Tags         admin
+// @Produce      json
+// @Param        id   path      string  true  "Delivery ID"
+// @Success      202  {object}  ResendResponse
+// @Failure      404  {string}  string  "not found"
+// @Router       /api/v1/admin/notifications/{id}/resend [post]
+func (h *Handler) ResendNotificationHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Outbox.Resend(uint(id)); err != nil {
+		if errors.Is(err, notification.ErrOutboxMessageNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to resend notification", http.StatusInternalServerError)
+		return
+	}
+
+	writeResend(w, 1)
+}
+
+// ResendDeadNotificationsHandler handles POST /admin/notifications/resend
+// @Summary      Resend every dead-lettered notification
+// @Tags         admin
+// @Produce      json
+// @Success      202  {object}  ResendResponse
+// @Router       /api/v1/admin/notifications/resend [post]
+func (h *Handler) ResendDeadNotificationsHandler(w http.ResponseWriter, r *http.Request) {
+	requeued, err := h.Outbox.ResendDead()
+	if err != nil {
+		http.Error(w, "Failed to resend notifications", http.StatusInternalServerError)
+		return
+	}
+
+	writeResend(w, requeued)
+}
+
+func writeResend(w http.ResponseWriter, requeued int64) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	err := json.NewEncoder(w).Encode(ResendResponse{Requeued: requeued})
+	if err != nil {
+		return
+	}
+}
+
+// PreviewNotificationHandler handles POST /admin/notifications/preview
+// @Summary      Preview a notification template
+// @Description  Renders a message template for a channel without sending it, against sample data unless data is given.
+// @Description  Pass template to try out an edit before saving it to NOTIFICATION_TEMPLATE_DIR.
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Param        request  body      PreviewRequest  true  "kind: buy, dip, signal, price_alert or summary; format: MarkdownV2, HTML, slack, discord or empty for plain text"
+// @Success      200      {object}  PreviewResponse
+// @Failure      400      {string}  string  "bad request"
+// @Failure      404      {string}  string  "not found"
+// @Router       /api/v1/admin/notifications/preview [post]
+func (h *Handler) PreviewNotificationHandler(w http.ResponseWriter, r *http.Request) {
+	var request PreviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid JSON body", http.StatusBadRequest)
+		return
+	}
+	if request.Kind == "" {
+		request.Kind = notification.KindSignal
+	}
+
+	format := h.Outbox.Format(request.Channel)
+	if request.Format != nil {
+		format = *request.Format
+	}
+	switch format {
+	case notification.FormatPlain, notification.FormatMarkdownV2, notification.FormatHTML, notification.FormatSlack, notification.FormatDiscord:
+	default:
+		http.Error(w, fmt.Sprintf("invalid 'format' %q", format), http.StatusBadRequest)
+		return
+	}
+
+	data := notification.SampleData(request.Kind)
+	if request.Data != nil {
+		data = *request.Data
+		data.Kind = request.Kind
+	}
+
+	var (
+		message string
+		err     error
+	)
+	if request.Template != "" {
+		message, err = notification.RenderText(request.Template, format, data)
+	} else {
+		message, err = h.Outbox.Templates().Render(request.Kind, request.Channel, format, data)
+	}
+	if err != nil {
+		if errors.Is(err, notification.ErrUnknownTemplate) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(PreviewResponse{Kind: request.Kind, Channel: request.Channel, Format: format, Message: message})
+	if err != nil {
+		return
+	}
+}
@@ -0,0 +1,195 @@
+package backtest
+
+import (
+	"errors"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"github.com/khorzhenwin/gold-digger/internal/strategy"
+	"github.com/khorzhenwin/gold-digger/internal/ticker-price"
+	"math"
+	"sort"
+	"time"
+)
+
+var ErrNoPrices = errors.New("no stored prices in the requested range")
+
+// DefaultHorizons are the forward return windows measured after each signal
+var DefaultHorizons = []time.Duration{time.Hour, 4 * time.Hour, 24 * time.Hour}
+
+type Config struct {
+	Symbol   string
+	Registry *strategy.Registry
+	// Horizons are measured in order, the first one drives hit rate and drawdown of the equity curve
+	Horizons []time.Duration
+	// EvaluateEvery defaults to the live worker's evaluation interval
+	EvaluateEvery time.Duration
+}
+
+// ForwardReturn is the price change from the signal to the first tick at least Horizon later.
+// Available is false when the history ends before the horizon.
+type ForwardReturn struct {
+	Horizon   time.Duration `json:"horizon"`
+	ReturnPct float64       `json:"return_pct"`
+	Available bool          `json:"available"`
+}
+
+type SignalResult struct {
+	Signal strategy.Signal `json:"signal"`
+	// FiredAt is the simulated time of the evaluation that produced the signal
+	FiredAt        time.Time       `json:"fired_at"`
+	Returns        []ForwardReturn `json:"returns"`
+	MaxDrawdownPct float64         `json:"max_drawdown_pct"` // worst dip below the signal price within the longest horizon
+}
+
+type HorizonStats struct {
+	Horizon      time.Duration `json:"horizon"`
+	Samples      int           `json:"samples"`
+	Hits         int           `json:"hits"`
+	HitRate      float64       `json:"hit_rate"`
+	AvgReturnPct float64       `json:"avg_return_pct"`
+}
+
+type Report struct {
+	Symbol      string         `json:"symbol"`
+	From        time.Time      `json:"from"`
+	To          time.Time      `json:"to"`
+	Ticks       int            `json:"ticks"`
+	Evaluations int            `json:"evaluations"`
+	Signals     []SignalResult `json:"signals"`
+	Horizons    []HorizonStats `json:"horizons"`
+	// MaxDrawdownPct is the deepest peak-to-trough fall of the equity curve from taking every
+	// signal as a long position closed after the first horizon
+	MaxDrawdownPct float64 `json:"max_drawdown_pct"`
+}
+
+// simClock is the signal engine's clock during a replay, it only moves when the replay advances it
+type simClock struct {
+	now time.Time
+}
+
+func (c *simClock) Now() time.Time {
+	return c.now
+}
+
+// Run replays prices, ordered oldest first, through a SignalEngine the way StartSignalWorker
+// drives it: ticks are added as they arrive and the strategies run every EvaluateEvery, with
+// the cooldown following simulated time. Both BUY and DIP are treated as long entries, so a
+// signal is a hit when the price is higher after the horizon.
+func Run(cfg Config, prices []models.TickerPrice) (*Report, error) {
+	if len(prices) == 0 {
+		return nil, ErrNoPrices
+	}
+	if cfg.Registry == nil {
+		cfg.Registry = strategy.NewRegistry()
+	}
+	if len(cfg.Horizons) == 0 {
+		cfg.Horizons = DefaultHorizons
+	}
+	if cfg.EvaluateEvery <= 0 {
+		cfg.EvaluateEvery = ticker_price.SignalEvaluationInterval
+	}
+
+	sort.SliceStable(prices, func(i, j int) bool {
+		return prices[i].Timestamp.Before(prices[j].Timestamp)
+	})
+
+	clock := &simClock{now: prices[0].Timestamp}
+	engine := ticker_price.NewSignalEngine(cfg.Registry, clock.Now)
+	report := &Report{
+		Symbol: cfg.Symbol,
+		From:   prices[0].Timestamp,
+		To:     prices[len(prices)-1].Timestamp,
+		Ticks:  len(prices),
+	}
+
+	evaluate := func(at time.Time) {
+		clock.now = at
+		report.Evaluations++
+		signals := engine.Evaluate(cfg.Symbol)
+		if len(signals) == 0 {
+			return
+		}
+		for _, signal := range signals {
+			report.Signals = append(report.Signals, measure(signal, at, prices, cfg.Horizons))
+		}
+		// the live worker starts the cooldown once delivery succeeded, a replay always delivers
+		engine.MarkSignalled(cfg.Symbol)
+	}
+
+	nextEvaluation := prices[0].Timestamp.Add(cfg.EvaluateEvery)
+	for _, price := range prices {
+		for !nextEvaluation.After(price.Timestamp) {
+			evaluate(nextEvaluation)
+			nextEvaluation = nextEvaluation.Add(cfg.EvaluateEvery)
+		}
+		clock.now = price.Timestamp
+		engine.Add(price)
+	}
+
+	report.Horizons, report.MaxDrawdownPct = summarize(report.Signals, cfg.Horizons)
+	return report, nil
+}
+
+func measure(signal strategy.Signal, at time.Time, prices []models.TickerPrice, horizons []time.Duration) SignalResult {
+	result := SignalResult{Signal: signal, FiredAt: at}
+	entry := signal.Price
+
+	// ticks stamped at the evaluation itself are added after it, so they already follow the entry
+	start := sort.Search(len(prices), func(i int) bool { return !prices[i].Timestamp.Before(at) })
+
+	for _, horizon := range horizons {
+		forward := ForwardReturn{Horizon: horizon}
+		target := at.Add(horizon)
+		i := sort.Search(len(prices), func(i int) bool { return !prices[i].Timestamp.Before(target) })
+		if i < len(prices) && entry != 0 {
+			forward.Available = true
+			forward.ReturnPct = (prices[i].Price - entry) / entry * 100
+		}
+		result.Returns = append(result.Returns, forward)
+	}
+
+	longest := at.Add(horizons[len(horizons)-1])
+	for i := start; i < len(prices) && !prices[i].Timestamp.After(longest); i++ {
+		if entry == 0 {
+			break
+		}
+		if dd := (prices[i].Price - entry) / entry * 100; dd < result.MaxDrawdownPct {
+			result.MaxDrawdownPct = dd
+		}
+	}
+	return result
+}
+
+func summarize(results []SignalResult, horizons []time.Duration) ([]HorizonStats, float64) {
+	stats := make([]HorizonStats, len(horizons))
+	for h, horizon := range horizons {
+		stats[h].Horizon = horizon
+		total := 0.0
+		for _, result := range results {
+			forward := result.Returns[h]
+			if !forward.Available {
+				continue
+			}
+			stats[h].Samples++
+			total += forward.ReturnPct
+			if forward.ReturnPct > 0 {
+				stats[h].Hits++
+			}
+		}
+		if stats[h].Samples > 0 {
+			stats[h].HitRate = float64(stats[h].Hits) / float64(stats[h].Samples)
+			stats[h].AvgReturnPct = total / float64(stats[h].Samples)
+		}
+	}
+
+	equity, peak, maxDrawdown := 1.0, 1.0, 0.0
+	for _, result := range results {
+		forward := result.Returns[0]
+		if !forward.Available {
+			continue
+		}
+		equity *= 1 + forward.ReturnPct/100
+		peak = math.Max(peak, equity)
+		maxDrawdown = math.Min(maxDrawdown, (equity-peak)/peak*100)
+	}
+	return stats, maxDrawdown
+}
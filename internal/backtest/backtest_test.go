@@ -0,0 +1,158 @@
+package backtest
+
+import (
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"github.com/khorzhenwin/gold-digger/internal/strategy"
+	"math"
+	"testing"
+	"time"
+)
+
+var start = time.Date(2026, time.October, 12, 14, 0, 0, 0, time.UTC)
+
+// risingStrategy buys whenever the last price of the window is above the one before it
+type risingStrategy struct{}
+
+func (risingStrategy) Name() string {
+	return "rising"
+}
+
+func (risingStrategy) Evaluate(symbol string, window []models.TickerPrice) []strategy.Signal {
+	if len(window) < 2 || window[len(window)-1].Price <= window[len(window)-2].Price {
+		return nil
+	}
+	last := window[len(window)-1]
+	return []strategy.Signal{{Symbol: symbol, Strategy: "rising", Side: strategy.SideBuy, Price: last.Price, WindowEnd: last.Timestamp}}
+}
+
+func risingRegistry(t *testing.T) *strategy.Registry {
+	t.Helper()
+	registry := strategy.NewRegistry()
+	registry.Register(risingStrategy{})
+	if err := registry.SetDefault("rising"); err != nil {
+		t.Fatal(err)
+	}
+	return registry
+}
+
+// quarterHours turns prices into ticks fifteen minutes apart
+func quarterHours(prices ...float64) []models.TickerPrice {
+	ticks := make([]models.TickerPrice, len(prices))
+	for i, price := range prices {
+		ticks[i] = models.TickerPrice{Symbol: "PLTR", Price: price, Timestamp: start.Add(time.Duration(i) * 15 * time.Minute)}
+	}
+	return ticks
+}
+
+func pct(from float64, to float64) float64 {
+	return (to - from) / from * 100
+}
+
+func near(a float64, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestRun(t *testing.T) {
+	// evaluations run every 15 minutes on the ticks before them, the strategy fires on every
+	// rise, so without the one hour cooldown it would also fire at 60 and 75 minutes
+	prices := quarterHours(100, 102, 90, 99, 101, 104, 95, 97, 110, 108, 112)
+	report, err := Run(Config{
+		Symbol:        "PLTR",
+		Registry:      risingRegistry(t),
+		Horizons:      []time.Duration{30 * time.Minute, time.Hour, 24 * time.Hour},
+		EvaluateEvery: 15 * time.Minute,
+	}, prices)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Ticks != 11 || report.Evaluations != 10 {
+		t.Errorf("got %d ticks and %d evaluations, want 11 and 10", report.Ticks, report.Evaluations)
+	}
+
+	want := []struct {
+		firedAt     time.Time
+		entry       float64
+		returns     []float64
+		maxDrawdown float64
+	}{
+		// the tick at 30 minutes arrives with the evaluation and is the deepest dip after it
+		{firedAt: start.Add(30 * time.Minute), entry: 102, returns: []float64{pct(102, 101), pct(102, 95)}, maxDrawdown: pct(102, 90)},
+		{firedAt: start.Add(90 * time.Minute), entry: 104, returns: []float64{pct(104, 110), pct(104, 112)}, maxDrawdown: pct(104, 95)},
+	}
+	if len(report.Signals) != len(want) {
+		t.Fatalf("got %d signals, want %d: %+v", len(report.Signals), len(want), report.Signals)
+	}
+	for i, w := range want {
+		got := report.Signals[i]
+		if !got.FiredAt.Equal(w.firedAt) || got.Signal.Price != w.entry {
+			t.Errorf("signal %d: got %.2f at %s, want %.2f at %s", i, got.Signal.Price, got.FiredAt, w.entry, w.firedAt)
+		}
+		for h, value := range w.returns {
+			if !got.Returns[h].Available || !near(got.Returns[h].ReturnPct, value) {
+				t.Errorf("signal %d: got %s return %+v, want %.4f%%", i, got.Returns[h].Horizon, got.Returns[h], value)
+			}
+		}
+		if got.Returns[2].Available {
+			t.Errorf("signal %d: got a 24h return from 2.5h of history", i)
+		}
+		if !near(got.MaxDrawdownPct, w.maxDrawdown) {
+			t.Errorf("signal %d: got max drawdown %.4f%%, want %.4f%%", i, got.MaxDrawdownPct, w.maxDrawdown)
+		}
+	}
+
+	wantStats := []HorizonStats{
+		{Horizon: 30 * time.Minute, Samples: 2, Hits: 1, HitRate: 0.5, AvgReturnPct: (pct(102, 101) + pct(104, 110)) / 2},
+		{Horizon: time.Hour, Samples: 2, Hits: 1, HitRate: 0.5, AvgReturnPct: (pct(102, 95) + pct(104, 112)) / 2},
+		{Horizon: 24 * time.Hour},
+	}
+	for h, w := range wantStats {
+		got := report.Horizons[h]
+		if got.Horizon != w.Horizon || got.Samples != w.Samples || got.Hits != w.Hits || !near(got.HitRate, w.HitRate) || !near(got.AvgReturnPct, w.AvgReturnPct) {
+			t.Errorf("got %+v, want %+v", got, w)
+		}
+	}
+	// only the first trade loses, the second one recovers above the starting equity
+	if !near(report.MaxDrawdownPct, pct(102, 101)) {
+		t.Errorf("got max drawdown %.4f%%, want %.4f%%", report.MaxDrawdownPct, pct(102, 101))
+	}
+}
+
+func TestRunWithoutPrices(t *testing.T) {
+	if _, err := Run(Config{Symbol: "PLTR"}, nil); err != ErrNoPrices {
+		t.Errorf("got error %v, want ErrNoPrices", err)
+	}
+}
+
+func TestEquityDrawdown(t *testing.T) {
+	result := func(returns ...float64) SignalResult {
+		forward := make([]ForwardReturn, len(returns))
+		for i, value := range returns {
+			forward[i] = ForwardReturn{ReturnPct: value, Available: true}
+		}
+		return SignalResult{Returns: forward}
+	}
+	unavailable := SignalResult{Returns: []ForwardReturn{{}}}
+
+	tests := []struct {
+		name    string
+		results []SignalResult
+		want    float64
+	}{
+		{name: "no signals"},
+		{name: "only gains", results: []SignalResult{result(5), result(3)}},
+		// equity 1.1, 0.88, 0.924, the trough is 20% below the 1.1 peak
+		{name: "a loss after a peak", results: []SignalResult{result(10), result(-20), result(5)}, want: -20},
+		// equity 0.9, 1.08, 0.756, 30% below the 1.08 peak rather than 10% below the start
+		{name: "the deepest fall", results: []SignalResult{result(-10), result(20), result(-30)}, want: -30},
+		{name: "unavailable returns are skipped", results: []SignalResult{result(10), unavailable, result(-5)}, want: -5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := summarize(tt.results, []time.Duration{time.Hour}); !near(got, tt.want) {
+				t.Errorf("got max drawdown %.4f%%, want %.4f%%", got, tt.want)
+			}
+		})
+	}
+}
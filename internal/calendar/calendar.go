@@ -0,0 +1,268 @@
+package calendar
+
+import (
+	"sync"
+	"time"
+	_ "time/tzdata" // exchange time zones must load on hosts without a zoneinfo database
+)
+
+// maxSearchDays bounds NextOpen and PreviousClose, no exchange closes for this long
+const maxSearchDays = 30
+
+// Holiday is a weekday an exchange is closed, or closes early when EarlyClose is set
+type Holiday struct {
+	Date       time.Time `json:"date"` // midnight in the exchange's time zone
+	Name       string    `json:"name"`
+	EarlyClose bool      `json:"early_close,omitempty"`
+}
+
+// day is a calendar date without a time zone
+type day struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func dayOf(t time.Time) day {
+	return day{t.Year(), t.Month(), t.Day()}
+}
+
+// MarketSession is the part of the trading day a time falls in
+type MarketSession string
+
+const (
+	SessionRegular    MarketSession = "regular"
+	SessionPreMarket  MarketSession = "pre"
+	SessionAfterHours MarketSession = "post"
+	SessionClosed     MarketSession = "closed"
+)
+
+// Rules lists an exchange's holidays and early closes for one year, days falling on a weekend are ignored
+type Rules func(year int) []Holiday
+
+// Exchange computes the trading sessions of one exchange from its rules in its local time zone
+type Exchange struct {
+	mic        string
+	name       string
+	location   *time.Location
+	open       time.Duration // since local midnight
+	close      time.Duration
+	earlyClose time.Duration
+	// breakStart and breakEnd pause the session for lunch, zero when there is no break.
+	// Early close days end at breakStart when it comes before earlyClose.
+	breakStart time.Duration
+	breakEnd   time.Duration
+	// preOpen and postClose bound the pre-market and after-hours sessions, zero when the
+	// exchange has none. After hours last as long after an early close as after a regular one.
+	preOpen   time.Duration
+	postClose time.Duration
+	// alwaysOpen exchanges, like crypto, trade around the clock
+	alwaysOpen bool
+	rules      Rules
+
+	mu    sync.Mutex
+	years map[int]map[day]Holiday
+}
+
+// withBreak adds a lunch break to the exchange's sessions
+func (e *Exchange) withBreak(start time.Duration, end time.Duration) *Exchange {
+	e.breakStart, e.breakEnd = start, end
+	return e
+}
+
+// withExtendedHours adds pre-market trading from preOpen and after-hours trading until postClose
+func (e *Exchange) withExtendedHours(preOpen time.Duration, postClose time.Duration) *Exchange {
+	e.preOpen, e.postClose = preOpen, postClose
+	return e
+}
+
+func newExchange(mic string, name string, timeZone string, open time.Duration, close time.Duration, earlyClose time.Duration, rules Rules) *Exchange {
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		// the zoneinfo database is embedded, so this only fails on a typo
+		panic(err)
+	}
+	return &Exchange{
+		mic:        mic,
+		name:       name,
+		location:   location,
+		open:       open,
+		close:      close,
+		earlyClose: earlyClose,
+		rules:      rules,
+		years:      make(map[int]map[day]Holiday),
+	}
+}
+
+// MIC is the exchange's ISO 10383 market identifier code, e.g. XNYS
+func (e *Exchange) MIC() string {
+	return e.mic
+}
+
+func (e *Exchange) Name() string {
+	return e.name
+}
+
+func (e *Exchange) Location() *time.Location {
+	return e.location
+}
+
+// AlwaysOpen reports whether the exchange trades around the clock
+func (e *Exchange) AlwaysOpen() bool {
+	return e.alwaysOpen
+}
+
+// Holidays lists the weekday closures and early closes of year in date order
+func (e *Exchange) Holidays(year int) []Holiday {
+	if e.rules == nil {
+		return nil
+	}
+	var holidays []Holiday
+	for _, holiday := range e.rules(year) {
+		if isWeekend(holiday.Date) {
+			continue
+		}
+		d := holiday.Date
+		holiday.Date = time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, e.location)
+		holidays = append(holidays, holiday)
+	}
+	return holidays
+}
+
+func (e *Exchange) holiday(t time.Time) (Holiday, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	year, ok := e.years[t.Year()]
+	if !ok {
+		year = make(map[day]Holiday)
+		for _, holiday := range e.Holidays(t.Year()) {
+			year[dayOf(holiday.Date)] = holiday
+		}
+		e.years[t.Year()] = year
+	}
+	holiday, ok := year[dayOf(t)]
+	return holiday, ok
+}
+
+// Session returns the open and close of the trading session on the local date of t, a
+// lunch break falls in between. ok is false on weekends and holidays. Always open
+// exchanges report the whole local day.
+func (e *Exchange) Session(t time.Time) (open time.Time, close time.Time, ok bool) {
+	periods := e.periods(t)
+	if len(periods) == 0 {
+		return time.Time{}, time.Time{}, false
+	}
+	return periods[0][0], periods[len(periods)-1][1], true
+}
+
+// periods are the trading periods on the local date of t, two when the session has a lunch break
+func (e *Exchange) periods(t time.Time) [][2]time.Time {
+	local := t.In(e.location)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, e.location)
+	if e.alwaysOpen {
+		return [][2]time.Time{{midnight, midnight.AddDate(0, 0, 1)}}
+	}
+	if isWeekend(local) {
+		return nil
+	}
+
+	closesAt := e.close
+	if holiday, found := e.holiday(local); found {
+		if !holiday.EarlyClose {
+			return nil
+		}
+		closesAt = e.earlyClose
+	}
+
+	if e.breakStart == 0 || closesAt <= e.breakStart {
+		return [][2]time.Time{{at(midnight, e.open), at(midnight, closesAt)}}
+	}
+	return [][2]time.Time{
+		{at(midnight, e.open), at(midnight, e.breakStart)},
+		{at(midnight, e.breakEnd), at(midnight, closesAt)},
+	}
+}
+
+// IsOpen reports whether the exchange is trading in its regular session at t
+func (e *Exchange) IsOpen(t time.Time) bool {
+	for _, period := range e.periods(t) {
+		if !t.Before(period[0]) && t.Before(period[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// SessionAt reports which session the exchange is in at t. Always open exchanges are
+// always in their regular session.
+func (e *Exchange) SessionAt(t time.Time) MarketSession {
+	if e.alwaysOpen || e.IsOpen(t) {
+		return SessionRegular
+	}
+	periods := e.periods(t)
+	if len(periods) == 0 || e.postClose == 0 {
+		return SessionClosed
+	}
+
+	open, close := periods[0][0], periods[len(periods)-1][1]
+	midnight := time.Date(open.Year(), open.Month(), open.Day(), 0, 0, 0, 0, e.location)
+	switch {
+	case !t.Before(at(midnight, e.preOpen)) && t.Before(open):
+		return SessionPreMarket
+	case !t.Before(close) && t.Before(close.Add(e.postClose-e.close)):
+		return SessionAfterHours
+	default:
+		return SessionClosed
+	}
+}
+
+// IsExtendedOpen reports whether the exchange is trading at t, in its regular session or in
+// pre-market or after-hours trading
+func (e *Exchange) IsExtendedOpen(t time.Time) bool {
+	return e.SessionAt(t) != SessionClosed
+}
+
+// NextOpen returns the first time after t trading starts, including the end of a lunch break.
+// Always open exchanges return t.
+func (e *Exchange) NextOpen(t time.Time) time.Time {
+	if e.alwaysOpen {
+		return t
+	}
+	local := t.In(e.location)
+	for i := 0; i <= maxSearchDays; i++ {
+		for _, period := range e.periods(local.AddDate(0, 0, i)) {
+			if period[0].After(t) {
+				return period[0]
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// PreviousClose returns the last time at or before t trading stopped, including the start of
+// a lunch break. Always open exchanges return t.
+func (e *Exchange) PreviousClose(t time.Time) time.Time {
+	if e.alwaysOpen {
+		return t
+	}
+	local := t.In(e.location)
+	for i := 0; i <= maxSearchDays; i++ {
+		periods := e.periods(local.AddDate(0, 0, -i))
+		for j := len(periods) - 1; j >= 0; j-- {
+			if !periods[j][1].After(t) {
+				return periods[j][1]
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// at is the wall clock time offset after midnight, which stays correct across DST changes
+func at(midnight time.Time, offset time.Duration) time.Time {
+	return time.Date(midnight.Year(), midnight.Month(), midnight.Day(), int(offset.Hours()), int(offset.Minutes())%60, 0, 0, midnight.Location())
+}
+
+func isWeekend(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+}
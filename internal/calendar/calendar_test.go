@@ -0,0 +1,213 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func local(exchange *Exchange, year int, month time.Month, day int, hour int, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, exchange.Location())
+}
+
+func TestNYSEHolidays(t *testing.T) {
+	tests := []struct {
+		name       string
+		date       time.Time
+		closed     bool
+		earlyClose bool
+	}{
+		{name: "New Year's Day on a Sunday moves to Monday", date: date(2023, time.January, 2), closed: true},
+		{name: "New Year's Day on a Saturday is not made up on Friday", date: date(2021, time.December, 31)},
+		{name: "New Year's Day on a Saturday is not made up on Friday", date: date(2027, time.December, 31)},
+		{name: "Martin Luther King Jr. Day", date: date(2026, time.January, 19), closed: true},
+		{name: "Washington's Birthday", date: date(2026, time.February, 16), closed: true},
+		{name: "Good Friday", date: date(2024, time.March, 29), closed: true},
+		{name: "Good Friday", date: date(2025, time.April, 18), closed: true},
+		{name: "Good Friday", date: date(2026, time.April, 3), closed: true},
+		{name: "Easter Monday is a trading day", date: date(2026, time.April, 6)},
+		{name: "Memorial Day", date: date(2026, time.May, 25), closed: true},
+		{name: "Juneteenth is not a holiday before 2022", date: date(2021, time.June, 18)},
+		{name: "Juneteenth on a Sunday moves to Monday", date: date(2022, time.June, 20), closed: true},
+		{name: "Juneteenth", date: date(2025, time.June, 19), closed: true},
+		{name: "Juneteenth on a Saturday moves to Friday", date: date(2027, time.June, 18), closed: true},
+		{name: "Independence Day on a Saturday moves to Friday", date: date(2026, time.July, 3), closed: true},
+		{name: "no early close before an observed Independence Day", date: date(2026, time.July, 2)},
+		{name: "Independence Day eve", date: date(2025, time.July, 3), earlyClose: true},
+		{name: "Labor Day", date: date(2026, time.September, 7), closed: true},
+		{name: "Thanksgiving Day", date: date(2026, time.November, 26), closed: true},
+		{name: "day after Thanksgiving", date: date(2026, time.November, 27), earlyClose: true},
+		{name: "Christmas Eve", date: date(2026, time.December, 24), earlyClose: true},
+		{name: "Christmas Day on a Sunday moves to Monday", date: date(2022, time.December, 26), closed: true},
+		{name: "Christmas Day on a Saturday moves to Friday", date: date(2027, time.December, 24), closed: true},
+		{name: "National Day of Mourning for Jimmy Carter", date: date(2025, time.January, 9), closed: true},
+		{name: "Hurricane Sandy", date: date(2012, time.October, 30), closed: true},
+		{name: "an ordinary weekday", date: date(2026, time.October, 16)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+" "+tt.date.Format("2006-01-02"), func(t *testing.T) {
+			holiday, found := NYSE.holiday(tt.date)
+			closed := found && !holiday.EarlyClose
+			earlyClose := found && holiday.EarlyClose
+			if closed != tt.closed || earlyClose != tt.earlyClose {
+				t.Errorf("got closed %t and early close %t (%q), want %t and %t", closed, earlyClose, holiday.Name, tt.closed, tt.earlyClose)
+			}
+		})
+	}
+}
+
+func TestHolidaysSkipWeekends(t *testing.T) {
+	for _, exchange := range []*Exchange{NYSE, LSE, SGX, HKEX} {
+		for year := 2020; year <= 2027; year++ {
+			for _, holiday := range exchange.Holidays(year) {
+				if isWeekend(holiday.Date) {
+					t.Errorf("%s %d: %s on %s falls on a weekend", exchange.MIC(), year, holiday.Name, holiday.Date.Format("Mon 2006-01-02"))
+				}
+				if holiday.Date.Location() != exchange.Location() {
+					t.Errorf("%s %d: %s is in %s, want %s", exchange.MIC(), year, holiday.Name, holiday.Date.Location(), exchange.Location())
+				}
+			}
+		}
+	}
+}
+
+func TestIsOpen(t *testing.T) {
+	tests := []struct {
+		name     string
+		exchange *Exchange
+		at       time.Time
+		open     bool
+	}{
+		{name: "NYSE at the open", exchange: NYSE, at: local(NYSE, 2026, time.October, 16, 9, 30), open: true},
+		{name: "NYSE before the open", exchange: NYSE, at: local(NYSE, 2026, time.October, 16, 9, 29)},
+		{name: "NYSE at the close", exchange: NYSE, at: local(NYSE, 2026, time.October, 16, 16, 0)},
+		{name: "NYSE on a Saturday", exchange: NYSE, at: local(NYSE, 2026, time.October, 17, 11, 0)},
+		{name: "NYSE on Good Friday", exchange: NYSE, at: local(NYSE, 2026, time.April, 3, 11, 0)},
+		{name: "NYSE before an early close", exchange: NYSE, at: local(NYSE, 2026, time.November, 27, 12, 59), open: true},
+		{name: "NYSE at an early close", exchange: NYSE, at: local(NYSE, 2026, time.November, 27, 13, 0)},
+		// DST started on March 8 2026, 09:30 EDT is 13:30 UTC
+		{name: "NYSE open after DST starts", exchange: NYSE, at: time.Date(2026, time.March, 9, 13, 30, 0, 0, time.UTC), open: true},
+		{name: "NYSE closed before the DST open", exchange: NYSE, at: time.Date(2026, time.March, 9, 13, 29, 0, 0, time.UTC)},
+		// DST ended on November 1 2026, 09:30 EST is 14:30 UTC
+		{name: "NYSE closed before the standard time open", exchange: NYSE, at: time.Date(2026, time.November, 2, 14, 29, 0, 0, time.UTC)},
+		{name: "NYSE open after DST ends", exchange: NYSE, at: time.Date(2026, time.November, 2, 14, 30, 0, 0, time.UTC), open: true},
+		{name: "NASDAQ follows NYSE holidays", exchange: NASDAQ, at: local(NASDAQ, 2026, time.November, 26, 11, 0)},
+		{name: "LSE morning", exchange: LSE, at: local(LSE, 2026, time.October, 16, 8, 0), open: true},
+		{name: "LSE substitute Boxing Day", exchange: LSE, at: local(LSE, 2026, time.December, 28, 10, 0)},
+		{name: "HKEX morning session", exchange: HKEX, at: local(HKEX, 2026, time.October, 16, 11, 59), open: true},
+		{name: "HKEX lunch break", exchange: HKEX, at: local(HKEX, 2026, time.October, 16, 12, 30)},
+		{name: "HKEX afternoon session", exchange: HKEX, at: local(HKEX, 2026, time.October, 16, 13, 0), open: true},
+		{name: "HKEX afternoon of Christmas Eve", exchange: HKEX, at: local(HKEX, 2026, time.December, 24, 13, 30)},
+		{name: "SGX on Chinese New Year", exchange: SGX, at: local(SGX, 2026, time.February, 17, 10, 0)},
+		{name: "SGX afternoon of Lunar New Year's Eve", exchange: SGX, at: local(SGX, 2026, time.February, 16, 12, 0)},
+		{name: "crypto on a Sunday night", exchange: Crypto, at: time.Date(2026, time.October, 18, 23, 59, 0, 0, time.UTC), open: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if open := tt.exchange.IsOpen(tt.at); open != tt.open {
+				t.Errorf("IsOpen(%s) = %t, want %t", tt.at, open, tt.open)
+			}
+		})
+	}
+}
+
+func TestNextOpen(t *testing.T) {
+	tests := []struct {
+		name     string
+		exchange *Exchange
+		from     time.Time
+		want     time.Time
+	}{
+		{name: "before the open", exchange: NYSE, from: local(NYSE, 2026, time.October, 16, 8, 0), want: local(NYSE, 2026, time.October, 16, 9, 30)},
+		{name: "during the session", exchange: NYSE, from: local(NYSE, 2026, time.October, 15, 10, 0), want: local(NYSE, 2026, time.October, 16, 9, 30)},
+		{name: "over the weekend", exchange: NYSE, from: local(NYSE, 2026, time.October, 16, 17, 0), want: local(NYSE, 2026, time.October, 19, 9, 30)},
+		{name: "over Good Friday and the weekend", exchange: NYSE, from: local(NYSE, 2026, time.April, 2, 16, 0), want: local(NYSE, 2026, time.April, 6, 9, 30)},
+		{name: "over Christmas", exchange: NYSE, from: local(NYSE, 2026, time.December, 24, 14, 0), want: local(NYSE, 2026, time.December, 28, 9, 30)},
+		{name: "over New Year's Day", exchange: NYSE, from: local(NYSE, 2025, time.December, 31, 16, 0), want: local(NYSE, 2026, time.January, 2, 9, 30)},
+		{name: "across the DST change", exchange: NYSE, from: local(NYSE, 2026, time.March, 6, 16, 0), want: time.Date(2026, time.March, 9, 13, 30, 0, 0, time.UTC)},
+		{name: "after the HKEX lunch break", exchange: HKEX, from: local(HKEX, 2026, time.October, 16, 12, 15), want: local(HKEX, 2026, time.October, 16, 13, 0)},
+		{name: "over the HKEX Lunar New Year", exchange: HKEX, from: local(HKEX, 2026, time.February, 16, 12, 0), want: local(HKEX, 2026, time.February, 20, 9, 30)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.exchange.NextOpen(tt.from); !got.Equal(tt.want) {
+				t.Errorf("NextOpen(%s) = %s, want %s", tt.from, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPreviousClose(t *testing.T) {
+	tests := []struct {
+		name     string
+		exchange *Exchange
+		from     time.Time
+		want     time.Time
+	}{
+		{name: "at the close", exchange: NYSE, from: local(NYSE, 2026, time.October, 16, 16, 0), want: local(NYSE, 2026, time.October, 16, 16, 0)},
+		{name: "during the session", exchange: NYSE, from: local(NYSE, 2026, time.October, 16, 10, 0), want: local(NYSE, 2026, time.October, 15, 16, 0)},
+		{name: "over the weekend", exchange: NYSE, from: local(NYSE, 2026, time.October, 19, 8, 0), want: local(NYSE, 2026, time.October, 16, 16, 0)},
+		{name: "after an early close", exchange: NYSE, from: local(NYSE, 2026, time.November, 27, 15, 0), want: local(NYSE, 2026, time.November, 27, 13, 0)},
+		{name: "over New Year's Day", exchange: NYSE, from: local(NYSE, 2026, time.January, 2, 9, 0), want: local(NYSE, 2025, time.December, 31, 16, 0)},
+		{name: "across the DST change", exchange: NYSE, from: local(NYSE, 2026, time.November, 2, 9, 0), want: time.Date(2026, time.October, 30, 20, 0, 0, 0, time.UTC)},
+		{name: "the HKEX lunch break", exchange: HKEX, from: local(HKEX, 2026, time.October, 16, 12, 30), want: local(HKEX, 2026, time.October, 16, 12, 0)},
+		{name: "the LSE Christmas Eve early close", exchange: LSE, from: local(LSE, 2026, time.December, 28, 9, 0), want: local(LSE, 2026, time.December, 24, 12, 30)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.exchange.PreviousClose(tt.from); !got.Equal(tt.want) {
+				t.Errorf("PreviousClose(%s) = %s, want %s", tt.from, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSessionAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		exchange *Exchange
+		at       time.Time
+		want     MarketSession
+	}{
+		{name: "before pre-market", exchange: NYSE, at: local(NYSE, 2026, time.October, 16, 3, 59), want: SessionClosed},
+		{name: "pre-market", exchange: NYSE, at: local(NYSE, 2026, time.October, 16, 4, 0), want: SessionPreMarket},
+		{name: "regular", exchange: NYSE, at: local(NYSE, 2026, time.October, 16, 9, 30), want: SessionRegular},
+		{name: "after hours", exchange: NYSE, at: local(NYSE, 2026, time.October, 16, 19, 59), want: SessionAfterHours},
+		{name: "after after hours", exchange: NYSE, at: local(NYSE, 2026, time.October, 16, 20, 0), want: SessionClosed},
+		{name: "after hours of an early close", exchange: NYSE, at: local(NYSE, 2026, time.November, 27, 16, 59), want: SessionAfterHours},
+		{name: "after the early close's after hours", exchange: NYSE, at: local(NYSE, 2026, time.November, 27, 17, 0), want: SessionClosed},
+		{name: "a holiday", exchange: NYSE, at: local(NYSE, 2026, time.November, 26, 6, 0), want: SessionClosed},
+		{name: "no extended hours on SGX", exchange: SGX, at: local(SGX, 2026, time.October, 16, 18, 0), want: SessionClosed},
+		{name: "crypto on a Saturday", exchange: Crypto, at: time.Date(2026, time.October, 17, 3, 0, 0, 0, time.UTC), want: SessionRegular},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.exchange.SessionAt(tt.at); got != tt.want {
+				t.Errorf("SessionAt(%s) = %s, want %s", tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInferMIC(t *testing.T) {
+	tests := map[string]string{
+		"PLTR":    "XNYS",
+		"d05.si":  "XSES",
+		"0700.HK": "XHKG",
+		"VOD.L":   "XLON",
+		"BTC/USD": CryptoMIC,
+		"ETH-USD": CryptoMIC,
+	}
+	for symbol, want := range tests {
+		if got := InferMIC(symbol); got != want {
+			t.Errorf("InferMIC(%q) = %s, want %s", symbol, got, want)
+		}
+		if _, ok := ForMIC(want); !ok {
+			t.Errorf("ForMIC(%q) found no exchange", want)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package calendar
+
+import (
+	"strings"
+	"time"
+)
+
+// CryptoMIC is not an ISO 10383 code, crypto has no single venue and trades around the clock
+const CryptoMIC = "CRYPTO"
+
+// Crypto is always open, its days are counted in UTC
+var Crypto = &Exchange{
+	mic:        CryptoMIC,
+	name:       "Crypto",
+	location:   time.UTC,
+	alwaysOpen: true,
+	years:      make(map[int]map[day]Holiday),
+}
+
+// Exchanges are the supported exchanges, in the order they are listed to users
+var Exchanges = []*Exchange{NYSE, NASDAQ, LSE, SGX, HKEX, Crypto}
+
+// ForMIC returns the exchange with the market identifier code mic, case insensitive
+func ForMIC(mic string) (*Exchange, bool) {
+	for _, exchange := range Exchanges {
+		if strings.EqualFold(exchange.mic, mic) {
+			return exchange, true
+		}
+	}
+	return nil, false
+}
+
+// MICs lists the market identifier codes of Exchanges
+func MICs() []string {
+	mics := make([]string, 0, len(Exchanges))
+	for _, exchange := range Exchanges {
+		mics = append(mics, exchange.mic)
+	}
+	return mics
+}
+
+// InferMIC guesses a symbol's exchange from its Yahoo/Twelve Data style suffix, e.g. D05.SI
+// trades on SGX and BTC/USD is crypto. Unsuffixed symbols are taken to be US listings on NYSE,
+// which shares its calendar with Nasdaq.
+func InferMIC(symbol string) string {
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	switch {
+	case strings.HasSuffix(symbol, ".SI"):
+		return SGX.mic
+	case strings.HasSuffix(symbol, ".HK"):
+		return HKEX.mic
+	case strings.HasSuffix(symbol, ".L"):
+		return LSE.mic
+	case strings.HasSuffix(symbol, "-USD"), strings.HasSuffix(symbol, "/USD"), strings.HasSuffix(symbol, "-USDT"), strings.HasSuffix(symbol, "/USDT"):
+		return CryptoMIC
+	default:
+		return NYSE.mic
+	}
+}
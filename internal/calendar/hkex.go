@@ -0,0 +1,76 @@
+package calendar
+
+import "time"
+
+// hkexTableHolidays are the Hong Kong general holidays following the lunar calendar, on their
+// actual dates, see lunarNewYear
+var hkexTableHolidays = map[int][]Holiday{
+	2024: {
+		{Date: date(2024, time.February, 10), Name: "Lunar New Year"},
+		{Date: date(2024, time.February, 11), Name: "Lunar New Year"},
+		{Date: date(2024, time.February, 12), Name: "Lunar New Year"},
+		{Date: date(2024, time.April, 4), Name: "Ching Ming Festival"},
+		{Date: date(2024, time.May, 15), Name: "Buddha's Birthday"},
+		{Date: date(2024, time.June, 10), Name: "Tuen Ng Festival"},
+		{Date: date(2024, time.September, 18), Name: "Day after Mid-Autumn Festival"},
+		{Date: date(2024, time.October, 11), Name: "Chung Yeung Festival"},
+	},
+	2025: {
+		{Date: date(2025, time.January, 29), Name: "Lunar New Year"},
+		{Date: date(2025, time.January, 30), Name: "Lunar New Year"},
+		{Date: date(2025, time.January, 31), Name: "Lunar New Year"},
+		{Date: date(2025, time.April, 4), Name: "Ching Ming Festival"},
+		{Date: date(2025, time.May, 5), Name: "Buddha's Birthday"},
+		{Date: date(2025, time.May, 31), Name: "Tuen Ng Festival"},
+		{Date: date(2025, time.October, 7), Name: "Day after Mid-Autumn Festival"},
+		{Date: date(2025, time.October, 29), Name: "Chung Yeung Festival"},
+	},
+	2026: {
+		{Date: date(2026, time.February, 17), Name: "Lunar New Year"},
+		{Date: date(2026, time.February, 18), Name: "Lunar New Year"},
+		{Date: date(2026, time.February, 19), Name: "Lunar New Year"},
+		{Date: date(2026, time.April, 5), Name: "Ching Ming Festival"},
+		{Date: date(2026, time.May, 24), Name: "Buddha's Birthday"},
+		{Date: date(2026, time.June, 19), Name: "Tuen Ng Festival"},
+		{Date: date(2026, time.September, 26), Name: "Day after Mid-Autumn Festival"},
+		{Date: date(2026, time.October, 18), Name: "Chung Yeung Festival"},
+	},
+	2027: {
+		{Date: date(2027, time.February, 6), Name: "Lunar New Year"},
+		{Date: date(2027, time.February, 7), Name: "Lunar New Year"},
+		{Date: date(2027, time.February, 8), Name: "Lunar New Year"},
+		{Date: date(2027, time.April, 5), Name: "Ching Ming Festival"},
+		{Date: date(2027, time.May, 13), Name: "Buddha's Birthday"},
+		{Date: date(2027, time.June, 9), Name: "Tuen Ng Festival"},
+		{Date: date(2027, time.September, 16), Name: "Day after Mid-Autumn Festival"},
+		{Date: date(2027, time.October, 8), Name: "Chung Yeung Festival"},
+	},
+}
+
+// HKEX trades 09:30 to 16:00 Hong Kong time with a lunch break from 12:00 to 13:00, and only
+// the morning session on the eves of Lunar New Year, Christmas and New Year
+var HKEX = newExchange("XHKG", "Hong Kong Stock Exchange", "Asia/Hong_Kong",
+	9*time.Hour+30*time.Minute, 16*time.Hour, 12*time.Hour, hkexRules).
+	withBreak(12*time.Hour, 13*time.Hour)
+
+// hkexRules are the Hong Kong general holidays: one on a Sunday is made up on the next day
+// that is not already a holiday, one on a Saturday is not
+func hkexRules(year int) []Holiday {
+	holidays := []Holiday{
+		{Date: date(year, time.January, 1), Name: "New Year's Day"},
+		{Date: easter(year).AddDate(0, 0, -2), Name: "Good Friday"},
+		{Date: easter(year).AddDate(0, 0, 1), Name: "Easter Monday"},
+		{Date: date(year, time.May, 1), Name: "Labour Day"},
+		{Date: date(year, time.July, 1), Name: "HKSAR Establishment Day"},
+		{Date: date(year, time.October, 1), Name: "National Day"},
+		{Date: date(year, time.December, 25), Name: "Christmas Day"},
+		{Date: date(year, time.December, 26), Name: "First weekday after Christmas Day"},
+	}
+	holidays = append(holidays, tableHolidays("XHKG", hkexTableHolidays, year)...)
+	holidays = substitute(holidays, time.Sunday)
+
+	return earlyCloses(holidays, append(lunarNewYearEve(year),
+		Holiday{Date: date(year, time.December, 24), Name: "Christmas Eve"},
+		Holiday{Date: date(year, time.December, 31), Name: "New Year's Eve"},
+	)...)
+}
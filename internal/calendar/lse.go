@@ -0,0 +1,49 @@
+package calendar
+
+import "time"
+
+// lseSpecialClosures are one-off bank holidays, mostly royal events
+var lseSpecialClosures = []Holiday{
+	{Date: date(1999, time.December, 31), Name: "Millennium celebrations"},
+	{Date: date(2002, time.June, 3), Name: "Golden Jubilee"},
+	{Date: date(2002, time.June, 4), Name: "Golden Jubilee"},
+	{Date: date(2011, time.April, 29), Name: "Royal Wedding"},
+	{Date: date(2012, time.June, 4), Name: "Spring Bank Holiday"},
+	{Date: date(2012, time.June, 5), Name: "Diamond Jubilee"},
+	{Date: date(2020, time.May, 8), Name: "Early May Bank Holiday (VE Day)"},
+	{Date: date(2022, time.June, 2), Name: "Spring Bank Holiday"},
+	{Date: date(2022, time.June, 3), Name: "Platinum Jubilee"},
+	{Date: date(2022, time.September, 19), Name: "State Funeral of Queen Elizabeth II"},
+	{Date: date(2023, time.May, 8), Name: "Coronation of King Charles III"},
+}
+
+// LSE trades 08:00 to 16:30 London time and closes at 12:30 on Christmas Eve and New Year's Eve
+var LSE = newExchange("XLON", "London Stock Exchange", "Europe/London",
+	8*time.Hour, 16*time.Hour+30*time.Minute, 12*time.Hour+30*time.Minute, lseRules)
+
+// lseRules are the England and Wales bank holidays: New Year's Day, Christmas and Boxing Day
+// on a weekend are made up on the following weekdays
+func lseRules(year int) []Holiday {
+	holidays := []Holiday{
+		{Date: date(year, time.January, 1), Name: "New Year's Day"},
+		{Date: easter(year).AddDate(0, 0, -2), Name: "Good Friday"},
+		{Date: easter(year).AddDate(0, 0, 1), Name: "Easter Monday"},
+		{Date: lastWeekday(year, time.August, time.Monday), Name: "Summer Bank Holiday"},
+		{Date: date(year, time.December, 25), Name: "Christmas Day"},
+		{Date: date(year, time.December, 26), Name: "Boxing Day"},
+	}
+	// the early May and spring bank holidays were moved for VE Day and the jubilees
+	if year != 2020 {
+		holidays = append(holidays, Holiday{Date: nthWeekday(year, time.May, time.Monday, 1), Name: "Early May Bank Holiday"})
+	}
+	if year != 2002 && year != 2012 && year != 2022 {
+		holidays = append(holidays, Holiday{Date: lastWeekday(year, time.May, time.Monday), Name: "Spring Bank Holiday"})
+	}
+	holidays = append(holidays, onYear(year, lseSpecialClosures)...)
+	holidays = substitute(holidays, time.Saturday, time.Sunday)
+
+	return earlyCloses(holidays,
+		Holiday{Date: date(year, time.December, 24), Name: "Christmas Eve"},
+		Holiday{Date: date(year, time.December, 31), Name: "New Year's Eve"},
+	)
+}
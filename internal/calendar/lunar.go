@@ -0,0 +1,42 @@
+package calendar
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// lunarNewYear is the first day of the Lunar New Year. Lunar and Islamic holidays have no
+// closed-form rule, so the Singapore and Hong Kong calendars carry them as tables gazetted
+// a year or so ahead; extend the tables when the next year is published.
+var lunarNewYear = map[int]time.Time{
+	2024: date(2024, time.February, 10),
+	2025: date(2025, time.January, 29),
+	2026: date(2026, time.February, 17),
+	2027: date(2027, time.February, 6),
+}
+
+// warnedTables remembers the exchange and year pairs already logged by tableHolidays
+var warnedTables sync.Map
+
+// tableHolidays returns the holidays table lists for year. A year the table does not cover
+// yet is logged once per exchange and its table holidays are treated as trading days.
+func tableHolidays(mic string, table map[int][]Holiday, year int) []Holiday {
+	holidays, ok := table[year]
+	if !ok {
+		if _, warned := warnedTables.LoadOrStore(fmt.Sprintf("%s/%d", mic, year), true); !warned {
+			log.Printf("⚠️ The %s holiday table has no %d dates, holidays like Lunar New Year count as trading days until they are added", mic, year)
+		}
+	}
+	return holidays
+}
+
+// lunarNewYearEve is the last day before the Lunar New Year, when Singapore and Hong Kong close at noon
+func lunarNewYearEve(year int) []Holiday {
+	newYear, ok := lunarNewYear[year]
+	if !ok {
+		return nil
+	}
+	return []Holiday{{Date: newYear.AddDate(0, 0, -1), Name: "Lunar New Year's Eve"}}
+}
@@ -0,0 +1,63 @@
+package calendar
+
+import "time"
+
+// nyseSpecialClosures are one-off closures outside the yearly rules
+var nyseSpecialClosures = []Holiday{
+	{Date: date(2001, time.September, 11), Name: "September 11 attacks"},
+	{Date: date(2001, time.September, 12), Name: "September 11 attacks"},
+	{Date: date(2001, time.September, 13), Name: "September 11 attacks"},
+	{Date: date(2001, time.September, 14), Name: "September 11 attacks"},
+	{Date: date(2004, time.June, 11), Name: "National Day of Mourning for Ronald Reagan"},
+	{Date: date(2007, time.January, 2), Name: "National Day of Mourning for Gerald Ford"},
+	{Date: date(2012, time.October, 29), Name: "Hurricane Sandy"},
+	{Date: date(2012, time.October, 30), Name: "Hurricane Sandy"},
+	{Date: date(2018, time.December, 5), Name: "National Day of Mourning for George H. W. Bush"},
+	{Date: date(2025, time.January, 9), Name: "National Day of Mourning for Jimmy Carter"},
+}
+
+var (
+	// NYSE trades 09:30 to 16:00 New York time and closes at 13:00 on early close days.
+	// Extended hours run from 04:00 pre-market to 20:00 after hours, 17:00 on early close days.
+	NYSE = newExchange("XNYS", "New York Stock Exchange", "America/New_York",
+		9*time.Hour+30*time.Minute, 16*time.Hour, 13*time.Hour, nyseRules).
+		withExtendedHours(4*time.Hour, 20*time.Hour)
+	// NASDAQ follows the NYSE holiday calendar and session times
+	NASDAQ = newExchange("XNAS", "Nasdaq", "America/New_York",
+		9*time.Hour+30*time.Minute, 16*time.Hour, 13*time.Hour, nyseRules).
+		withExtendedHours(4*time.Hour, 20*time.Hour)
+)
+
+// nyseRules are the NYSE holiday rules: fixed-date holidays falling on a Sunday move to
+// Monday and those falling on a Saturday to Friday, except New Year's Day, which is not
+// made up on the last trading day of the year before.
+func nyseRules(year int) []Holiday {
+	holidays := []Holiday{
+		{Date: nthWeekday(year, time.February, time.Monday, 3), Name: "Washington's Birthday"},
+		{Date: easter(year).AddDate(0, 0, -2), Name: "Good Friday"},
+		{Date: lastWeekday(year, time.May, time.Monday), Name: "Memorial Day"},
+		{Date: observed(date(year, time.July, 4)), Name: "Independence Day"},
+		{Date: nthWeekday(year, time.September, time.Monday, 1), Name: "Labor Day"},
+		{Date: nthWeekday(year, time.November, time.Thursday, 4), Name: "Thanksgiving Day"},
+		{Date: observed(date(year, time.December, 25)), Name: "Christmas Day"},
+	}
+
+	if newYear := date(year, time.January, 1); newYear.Weekday() == time.Sunday {
+		holidays = append(holidays, Holiday{Date: newYear.AddDate(0, 0, 1), Name: "New Year's Day"})
+	} else {
+		holidays = append(holidays, Holiday{Date: newYear, Name: "New Year's Day"})
+	}
+	if year >= 1998 {
+		holidays = append(holidays, Holiday{Date: nthWeekday(year, time.January, time.Monday, 3), Name: "Martin Luther King Jr. Day"})
+	}
+	if year >= 2022 {
+		holidays = append(holidays, Holiday{Date: observed(date(year, time.June, 19)), Name: "Juneteenth"})
+	}
+	holidays = append(holidays, onYear(year, nyseSpecialClosures)...)
+
+	return earlyCloses(holidays,
+		Holiday{Date: date(year, time.July, 3), Name: "Independence Day eve"},
+		Holiday{Date: nthWeekday(year, time.November, time.Thursday, 4).AddDate(0, 0, 1), Name: "Day after Thanksgiving"},
+		Holiday{Date: date(year, time.December, 24), Name: "Christmas Eve"},
+	)
+}
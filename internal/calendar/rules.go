@@ -0,0 +1,120 @@
+package calendar
+
+import (
+	"sort"
+	"time"
+)
+
+// date is midnight UTC, rules only compare calendar dates so the zone does not matter
+func date(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+// nthWeekday is the nth weekday of month, e.g. the third Monday of January
+func nthWeekday(year int, month time.Month, weekday time.Weekday, n int) time.Time {
+	first := date(year, month, 1)
+	offset := (int(weekday) - int(first.Weekday()) + 7) % 7
+	return first.AddDate(0, 0, offset+(n-1)*7)
+}
+
+// lastWeekday is the last weekday of month, e.g. the last Monday of May
+func lastWeekday(year int, month time.Month, weekday time.Weekday) time.Time {
+	last := date(year, month+1, 0)
+	offset := (int(last.Weekday()) - int(weekday) + 7) % 7
+	return last.AddDate(0, 0, -offset)
+}
+
+// observed moves a holiday on a Saturday to the Friday before and one on a Sunday to the Monday after
+func observed(d time.Time) time.Time {
+	switch d.Weekday() {
+	case time.Saturday:
+		return d.AddDate(0, 0, -1)
+	case time.Sunday:
+		return d.AddDate(0, 0, 1)
+	default:
+		return d
+	}
+}
+
+// easter is Easter Sunday of the Gregorian calendar, by the anonymous Gregorian algorithm
+func easter(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	return date(year, time.Month(month), (h+l-7*m+114)%31+1)
+}
+
+func sortHolidays(holidays []Holiday) {
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].Date.Before(holidays[j].Date)
+	})
+}
+
+// substitute adds a substitute holiday for each holiday on one of the given weekend days, on
+// the next weekday that is not already a holiday. Holidays are handled in date order, so
+// Christmas on a Saturday moves to Monday and Boxing Day on the Sunday to Tuesday.
+func substitute(holidays []Holiday, weekend ...time.Weekday) []Holiday {
+	sortHolidays(holidays)
+
+	taken := make(map[day]bool, len(holidays))
+	for _, holiday := range holidays {
+		taken[dayOf(holiday.Date)] = true
+	}
+
+	moves := make(map[time.Weekday]bool, len(weekend))
+	for _, weekday := range weekend {
+		moves[weekday] = true
+	}
+
+	result := append([]Holiday(nil), holidays...)
+	for _, holiday := range holidays {
+		if holiday.EarlyClose || !moves[holiday.Date.Weekday()] {
+			continue
+		}
+		d := holiday.Date.AddDate(0, 0, 1)
+		for isWeekend(d) || taken[dayOf(d)] {
+			d = d.AddDate(0, 0, 1)
+		}
+		taken[dayOf(d)] = true
+		result = append(result, Holiday{Date: d, Name: holiday.Name + " (observed)"})
+	}
+	sortHolidays(result)
+	return result
+}
+
+// earlyCloses adds an early close for each trading weekday in days that is not already a holiday
+func earlyCloses(holidays []Holiday, days ...Holiday) []Holiday {
+	closed := make(map[day]bool, len(holidays))
+	for _, holiday := range holidays {
+		closed[dayOf(holiday.Date)] = true
+	}
+	for _, d := range days {
+		if !closed[dayOf(d.Date)] && !isWeekend(d.Date) {
+			d.EarlyClose = true
+			holidays = append(holidays, d)
+		}
+	}
+	sortHolidays(holidays)
+	return holidays
+}
+
+// onYear keeps the holidays of a one-off list that fall in year
+func onYear(year int, holidays []Holiday) []Holiday {
+	var result []Holiday
+	for _, holiday := range holidays {
+		if holiday.Date.Year() == year {
+			result = append(result, holiday)
+		}
+	}
+	return result
+}
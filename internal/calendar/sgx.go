@@ -0,0 +1,70 @@
+package calendar
+
+import "time"
+
+// sgxTableHolidays are the Singapore public holidays following the lunar and Islamic calendars,
+// on their actual dates, see lunarNewYear
+var sgxTableHolidays = map[int][]Holiday{
+	2024: {
+		{Date: date(2024, time.February, 10), Name: "Chinese New Year"},
+		{Date: date(2024, time.February, 11), Name: "Chinese New Year"},
+		{Date: date(2024, time.April, 10), Name: "Hari Raya Puasa"},
+		{Date: date(2024, time.May, 22), Name: "Vesak Day"},
+		{Date: date(2024, time.June, 17), Name: "Hari Raya Haji"},
+		{Date: date(2024, time.October, 31), Name: "Deepavali"},
+	},
+	2025: {
+		{Date: date(2025, time.January, 29), Name: "Chinese New Year"},
+		{Date: date(2025, time.January, 30), Name: "Chinese New Year"},
+		{Date: date(2025, time.March, 31), Name: "Hari Raya Puasa"},
+		{Date: date(2025, time.May, 12), Name: "Vesak Day"},
+		{Date: date(2025, time.June, 7), Name: "Hari Raya Haji"},
+		{Date: date(2025, time.October, 20), Name: "Deepavali"},
+	},
+	2026: {
+		{Date: date(2026, time.February, 17), Name: "Chinese New Year"},
+		{Date: date(2026, time.February, 18), Name: "Chinese New Year"},
+		{Date: date(2026, time.March, 21), Name: "Hari Raya Puasa"},
+		{Date: date(2026, time.May, 27), Name: "Hari Raya Haji"},
+		{Date: date(2026, time.May, 31), Name: "Vesak Day"},
+		{Date: date(2026, time.November, 8), Name: "Deepavali"},
+	},
+	2027: {
+		{Date: date(2027, time.February, 6), Name: "Chinese New Year"},
+		{Date: date(2027, time.February, 7), Name: "Chinese New Year"},
+		{Date: date(2027, time.March, 10), Name: "Hari Raya Puasa"},
+		{Date: date(2027, time.May, 17), Name: "Hari Raya Haji"},
+		{Date: date(2027, time.May, 20), Name: "Vesak Day"},
+		{Date: date(2027, time.October, 28), Name: "Deepavali"},
+	},
+}
+
+// sgxSpecialClosures are one-off public holidays like polling days
+var sgxSpecialClosures = []Holiday{
+	{Date: date(2023, time.September, 1), Name: "Polling Day"},
+}
+
+// SGX trades 09:00 to 17:00 Singapore time and closes at 12:00 on the eves of Lunar New Year,
+// Christmas and New Year
+var SGX = newExchange("XSES", "Singapore Exchange", "Asia/Singapore",
+	9*time.Hour, 17*time.Hour, 12*time.Hour, sgxRules)
+
+// sgxRules are the Singapore public holidays: one on a Sunday is made up on the Monday after,
+// one on a Saturday is not
+func sgxRules(year int) []Holiday {
+	holidays := []Holiday{
+		{Date: date(year, time.January, 1), Name: "New Year's Day"},
+		{Date: easter(year).AddDate(0, 0, -2), Name: "Good Friday"},
+		{Date: date(year, time.May, 1), Name: "Labour Day"},
+		{Date: date(year, time.August, 9), Name: "National Day"},
+		{Date: date(year, time.December, 25), Name: "Christmas Day"},
+	}
+	holidays = append(holidays, tableHolidays("XSES", sgxTableHolidays, year)...)
+	holidays = append(holidays, onYear(year, sgxSpecialClosures)...)
+	holidays = substitute(holidays, time.Sunday)
+
+	return earlyCloses(holidays, append(lunarNewYearEve(year),
+		Holiday{Date: date(year, time.December, 24), Name: "Christmas Eve"},
+		Holiday{Date: date(year, time.December, 31), Name: "New Year's Eve"},
+	)...)
+}
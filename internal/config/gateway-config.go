@@ -0,0 +1,55 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	// RestModeChi serves only the hand-written chi handlers
+	RestModeChi = "chi"
+	// RestModeGateway serves the grpc-gateway generated from the proto instead of the chi handlers
+	RestModeGateway = "gateway"
+	// RestModeBoth serves chi under the REST base path and the gateway under its own base path
+	RestModeBoth = "both"
+)
+
+type GatewayConfig struct {
+	Mode     string
+	BasePath string
+}
+
+func LoadGatewayConfig() (*GatewayConfig, error) {
+	cfg := &GatewayConfig{
+		Mode:     strings.ToLower(strings.TrimSpace(os.Getenv("REST_MODE"))),
+		BasePath: strings.TrimRight(strings.TrimSpace(os.Getenv("GATEWAY_BASE_PATH")), "/"),
+	}
+
+	if cfg.Mode == "" {
+		cfg.Mode = RestModeChi
+	}
+
+	switch cfg.Mode {
+	case RestModeChi:
+	case RestModeGateway:
+		if cfg.BasePath == "" {
+			cfg.BasePath = "/api/v1"
+		}
+	case RestModeBoth:
+		if cfg.BasePath == "" {
+			cfg.BasePath = "/api/v2"
+		}
+		if cfg.BasePath == "/api/v1" {
+			return nil, fmt.Errorf("GATEWAY_BASE_PATH must differ from /api/v1 when REST_MODE=both")
+		}
+	default:
+		return nil, fmt.Errorf("invalid REST_MODE %q, expected chi, gateway or both", cfg.Mode)
+	}
+
+	if cfg.BasePath != "" && !strings.HasPrefix(cfg.BasePath, "/") {
+		return nil, fmt.Errorf("GATEWAY_BASE_PATH must start with /")
+	}
+
+	return cfg, nil
+}
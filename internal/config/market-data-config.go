@@ -0,0 +1,56 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type MarketDataConfig struct {
+	Provider string
+	// RequestsPerMinute caps outbound calls, 0 uses the provider's own quota
+	RequestsPerMinute int
+	// MaxConcurrency caps requests in flight at once
+	MaxConcurrency int
+	// QueueTimeout is how long a call without its own deadline may wait for a slot before giving up
+	QueueTimeout time.Duration
+}
+
+func LoadMarketDataConfig() (*MarketDataConfig, error) {
+	cfg := &MarketDataConfig{
+		Provider:       strings.ToLower(strings.TrimSpace(os.Getenv("MARKET_DATA_PROVIDER"))),
+		MaxConcurrency: 2,
+		QueueTimeout:   2 * time.Minute,
+	}
+
+	// Alpha Vantage stays the default so existing deployments keep working unchanged
+	if cfg.Provider == "" {
+		cfg.Provider = "alphavantage"
+	}
+
+	if value := strings.TrimSpace(os.Getenv("MARKET_DATA_RATE_PER_MINUTE")); value != "" {
+		rate, err := strconv.Atoi(value)
+		if err != nil || rate < 0 {
+			return nil, fmt.Errorf("invalid MARKET_DATA_RATE_PER_MINUTE %q", value)
+		}
+		cfg.RequestsPerMinute = rate
+	}
+	if value := strings.TrimSpace(os.Getenv("MARKET_DATA_MAX_CONCURRENCY")); value != "" {
+		concurrency, err := strconv.Atoi(value)
+		if err != nil || concurrency < 1 {
+			return nil, fmt.Errorf("invalid MARKET_DATA_MAX_CONCURRENCY %q", value)
+		}
+		cfg.MaxConcurrency = concurrency
+	}
+	if value := strings.TrimSpace(os.Getenv("MARKET_DATA_QUEUE_TIMEOUT")); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout < 0 {
+			return nil, fmt.Errorf("invalid MARKET_DATA_QUEUE_TIMEOUT %q", value)
+		}
+		cfg.QueueTimeout = timeout
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,141 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NotificationPolicyConfig decides when queued notifications may go out. Clock times are
+// minutes after midnight in Location.
+type NotificationPolicyConfig struct {
+	Location *time.Location
+	// QuietStart and QuietEnd hold notifications back in between, the range may wrap past midnight.
+	// Equal values disable quiet hours.
+	QuietStart int
+	QuietEnd   int
+	// DigestWindow folds every notification queued within the same window into one digest, 0 disables it
+	DigestWindow time.Duration
+	// MaxPerHour caps the deliveries of each channel per rolling hour, 0 is unlimited
+	MaxPerHour int
+	// ChannelMaxPerHour overrides MaxPerHour for single channels
+	ChannelMaxPerHour map[string]int
+	// SummaryAt is when the end-of-day summary is sent on days a watched exchange trades, -1 disables it
+	SummaryAt int
+}
+
+// QuietUntil returns the end of the quiet hours t falls into, or the zero time outside them
+func (c *NotificationPolicyConfig) QuietUntil(t time.Time) time.Time {
+	if c.QuietStart == c.QuietEnd {
+		return time.Time{}
+	}
+
+	local := t.In(c.Location)
+	minute := local.Hour()*60 + local.Minute()
+	var quiet bool
+	if c.QuietStart < c.QuietEnd {
+		quiet = minute >= c.QuietStart && minute < c.QuietEnd
+	} else {
+		quiet = minute >= c.QuietStart || minute < c.QuietEnd
+	}
+	if !quiet {
+		return time.Time{}
+	}
+
+	end := atMinute(local, c.QuietEnd)
+	if !end.After(local) {
+		end = atMinute(local.AddDate(0, 0, 1), c.QuietEnd)
+	}
+	return end
+}
+
+// CapFor is the hourly cap of channel, 0 is unlimited
+func (c *NotificationPolicyConfig) CapFor(channel string) int {
+	if limit, ok := c.ChannelMaxPerHour[channel]; ok {
+		return limit
+	}
+	return c.MaxPerHour
+}
+
+func atMinute(day time.Time, minute int) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), minute/60, minute%60, 0, 0, day.Location())
+}
+
+// LoadNotificationPolicyConfig reads NOTIFY_* variables, every policy is off unless set:
+//
+//	NOTIFY_TIMEZONE=Asia/Singapore NOTIFY_QUIET_HOURS=22:00-07:00 NOTIFY_DIGEST_WINDOW=10m
+//	NOTIFY_MAX_PER_HOUR=20 NOTIFY_CHANNEL_MAX_PER_HOUR=email=4,slack=10 NOTIFY_SUMMARY_AT=16:30
+func LoadNotificationPolicyConfig() (*NotificationPolicyConfig, error) {
+	cfg := &NotificationPolicyConfig{
+		Location:          time.UTC,
+		ChannelMaxPerHour: make(map[string]int),
+		SummaryAt:         -1,
+	}
+
+	if value := strings.TrimSpace(os.Getenv("NOTIFY_TIMEZONE")); value != "" {
+		location, err := time.LoadLocation(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid NOTIFY_TIMEZONE %q: %w", value, err)
+		}
+		cfg.Location = location
+	}
+
+	if value := strings.TrimSpace(os.Getenv("NOTIFY_QUIET_HOURS")); value != "" {
+		start, end, found := strings.Cut(value, "-")
+		var startErr, endErr error
+		cfg.QuietStart, startErr = parseClock(start)
+		cfg.QuietEnd, endErr = parseClock(end)
+		if !found || startErr != nil || endErr != nil {
+			return nil, fmt.Errorf("invalid NOTIFY_QUIET_HOURS %q, expected e.g. 22:00-07:00", value)
+		}
+	}
+
+	if value := strings.TrimSpace(os.Getenv("NOTIFY_DIGEST_WINDOW")); value != "" {
+		window, err := time.ParseDuration(value)
+		if err != nil || window < 0 || window > 24*time.Hour {
+			return nil, fmt.Errorf("invalid NOTIFY_DIGEST_WINDOW %q", value)
+		}
+		cfg.DigestWindow = window
+	}
+
+	if value := strings.TrimSpace(os.Getenv("NOTIFY_MAX_PER_HOUR")); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid NOTIFY_MAX_PER_HOUR %q", value)
+		}
+		cfg.MaxPerHour = limit
+	}
+
+	for _, pair := range strings.Split(os.Getenv("NOTIFY_CHANNEL_MAX_PER_HOUR"), ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		channel, value, found := strings.Cut(pair, "=")
+		limit, err := strconv.Atoi(strings.TrimSpace(value))
+		if !found || err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid NOTIFY_CHANNEL_MAX_PER_HOUR entry %q, expected channel=limit", pair)
+		}
+		cfg.ChannelMaxPerHour[strings.TrimSpace(channel)] = limit
+	}
+
+	if value := strings.TrimSpace(os.Getenv("NOTIFY_SUMMARY_AT")); value != "" {
+		at, err := parseClock(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid NOTIFY_SUMMARY_AT %q, expected e.g. 16:30", value)
+		}
+		cfg.SummaryAt = at
+	}
+
+	return cfg, nil
+}
+
+// parseClock turns "22:30" into minutes after midnight
+func parseClock(value string) (int, error) {
+	parsed, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, err
+	}
+	return parsed.Hour()*60 + parsed.Minute(), nil
+}
@@ -4,16 +4,84 @@ import (
 	"fmt"
 	"github.com/khorzhenwin/gold-digger/internal/models"
 	"os"
+	"strconv"
+	"strings"
 )
 
-func LoadNotifierConfig() (*models.TelegramNotifier, error) {
-	cfg := &models.TelegramNotifier{
-		BotToken: os.Getenv("TELEGRAM_BOT_TOKEN"),
-		ChatID:   os.Getenv("TELEGRAM_CHAT_ID"),
+// LoadNotifierConfig reads each channel with its own <CHANNEL>_ENABLED flag.
+// Telegram stays enabled unless TELEGRAM_ENABLED=false, the other channels are opt-in.
+func LoadNotifierConfig() (*models.NotifierConfig, error) {
+	cfg := &models.NotifierConfig{
+		Telegram: models.TelegramNotifier{
+			Enabled:  os.Getenv("TELEGRAM_ENABLED") != "false",
+			BotToken: os.Getenv("TELEGRAM_BOT_TOKEN"),
+			ChatID:   os.Getenv("TELEGRAM_CHAT_ID"),
+		},
+		Slack: models.SlackNotifier{
+			Enabled:    os.Getenv("SLACK_ENABLED") == "true",
+			WebhookURL: os.Getenv("SLACK_WEBHOOK_URL"),
+		},
+		Discord: models.DiscordNotifier{
+			Enabled:    os.Getenv("DISCORD_ENABLED") == "true",
+			WebhookURL: os.Getenv("DISCORD_WEBHOOK_URL"),
+		},
+		Email: models.EmailNotifier{
+			Enabled:  os.Getenv("SMTP_ENABLED") == "true",
+			Host:     os.Getenv("SMTP_HOST"),
+			Port:     587,
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     os.Getenv("SMTP_FROM"),
+		},
+		Webhook: models.WebhookNotifier{
+			Enabled: os.Getenv("WEBHOOK_ENABLED") == "true",
+			URL:     os.Getenv("WEBHOOK_URL"),
+			Secret:  os.Getenv("WEBHOOK_SECRET"),
+		},
+		TemplateDir: os.Getenv("NOTIFICATION_TEMPLATE_DIR"),
 	}
 
-	if cfg.BotToken == "" || cfg.ChatID == "" {
-		return nil, fmt.Errorf("incomplete Notifier config")
+	// plain text unless a parse mode is opted into, messages are escaped for whichever mode is set
+	// but custom templates must then produce valid MarkdownV2 or HTML themselves
+	switch mode := os.Getenv("TELEGRAM_PARSE_MODE"); mode {
+	case "", "none":
+	case "MarkdownV2", "HTML":
+		cfg.Telegram.ParseMode = mode
+	default:
+		return nil, fmt.Errorf("invalid TELEGRAM_PARSE_MODE %q, expected MarkdownV2, HTML or none", mode)
+	}
+
+	if value := os.Getenv("SMTP_PORT"); value != "" {
+		port, err := strconv.Atoi(value)
+		if err != nil || port <= 0 {
+			return nil, fmt.Errorf("invalid SMTP_PORT %q", value)
+		}
+		cfg.Email.Port = port
+	}
+	for _, to := range strings.Split(os.Getenv("SMTP_TO"), ",") {
+		if to = strings.TrimSpace(to); to != "" {
+			cfg.Email.To = append(cfg.Email.To, to)
+		}
+	}
+
+	if cfg.Telegram.Enabled && (cfg.Telegram.BotToken == "" || cfg.Telegram.ChatID == "") {
+		return nil, fmt.Errorf("incomplete Notifier config: TELEGRAM_BOT_TOKEN and TELEGRAM_CHAT_ID are required, or set TELEGRAM_ENABLED=false")
+	}
+	if cfg.Slack.Enabled && cfg.Slack.WebhookURL == "" {
+		return nil, fmt.Errorf("incomplete Notifier config: SLACK_WEBHOOK_URL is required when SLACK_ENABLED=true")
+	}
+	if cfg.Discord.Enabled && cfg.Discord.WebhookURL == "" {
+		return nil, fmt.Errorf("incomplete Notifier config: DISCORD_WEBHOOK_URL is required when DISCORD_ENABLED=true")
+	}
+	if cfg.Email.Enabled && (cfg.Email.Host == "" || cfg.Email.From == "" || len(cfg.Email.To) == 0) {
+		return nil, fmt.Errorf("incomplete Notifier config: SMTP_HOST, SMTP_FROM and SMTP_TO are required when SMTP_ENABLED=true")
+	}
+	if cfg.Webhook.Enabled && cfg.Webhook.URL == "" {
+		return nil, fmt.Errorf("incomplete Notifier config: WEBHOOK_URL is required when WEBHOOK_ENABLED=true")
+	}
+
+	if !cfg.Telegram.Enabled && !cfg.Slack.Enabled && !cfg.Discord.Enabled && !cfg.Email.Enabled && !cfg.Webhook.Enabled {
+		return nil, fmt.Errorf("incomplete Notifier config: no notification channel is enabled")
 	}
 
 	return cfg, nil
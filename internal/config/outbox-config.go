@@ -0,0 +1,66 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type OutboxConfig struct {
+	// MaxAttempts is how often a delivery is tried before it is dead-lettered
+	MaxAttempts int
+	// BaseBackoff is the wait after the first failure, doubled after every further failure up to MaxBackoff
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// Retention is how long sent and digested messages are kept, 0 keeps them forever
+	Retention time.Duration
+}
+
+func LoadOutboxConfig() (*OutboxConfig, error) {
+	cfg := &OutboxConfig{
+		MaxAttempts: 8,
+		BaseBackoff: 30 * time.Second,
+		MaxBackoff:  time.Hour,
+		Retention:   30 * 24 * time.Hour,
+	}
+
+	if value := strings.TrimSpace(os.Getenv("OUTBOX_MAX_ATTEMPTS")); value != "" {
+		attempts, err := strconv.Atoi(value)
+		if err != nil || attempts < 1 {
+			return nil, fmt.Errorf("invalid OUTBOX_MAX_ATTEMPTS %q", value)
+		}
+		cfg.MaxAttempts = attempts
+	}
+
+	if value := strings.TrimSpace(os.Getenv("OUTBOX_BASE_BACKOFF")); value != "" {
+		backoff, err := time.ParseDuration(value)
+		if err != nil || backoff <= 0 {
+			return nil, fmt.Errorf("invalid OUTBOX_BASE_BACKOFF %q", value)
+		}
+		cfg.BaseBackoff = backoff
+	}
+
+	if value := strings.TrimSpace(os.Getenv("OUTBOX_MAX_BACKOFF")); value != "" {
+		backoff, err := time.ParseDuration(value)
+		if err != nil || backoff <= 0 {
+			return nil, fmt.Errorf("invalid OUTBOX_MAX_BACKOFF %q", value)
+		}
+		cfg.MaxBackoff = backoff
+	}
+
+	if value := strings.TrimSpace(os.Getenv("OUTBOX_RETENTION")); value != "" {
+		retention, err := time.ParseDuration(value)
+		if err != nil || retention < 0 {
+			return nil, fmt.Errorf("invalid OUTBOX_RETENTION %q", value)
+		}
+		cfg.Retention = retention
+	}
+
+	if cfg.MaxBackoff < cfg.BaseBackoff {
+		return nil, fmt.Errorf("OUTBOX_MAX_BACKOFF must not be shorter than OUTBOX_BASE_BACKOFF")
+	}
+
+	return cfg, nil
+}
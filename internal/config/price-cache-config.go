@@ -0,0 +1,30 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+type PriceCacheConfig struct {
+	// Freshness is how old a cached or stored price may be before the provider is called
+	Freshness time.Duration
+}
+
+func LoadPriceCacheConfig() (*PriceCacheConfig, error) {
+	cfg := &PriceCacheConfig{
+		// matches the poll interval, so a polled symbol never needs a live call
+		Freshness: 5 * time.Minute,
+	}
+
+	if value := strings.TrimSpace(os.Getenv("PRICE_FRESHNESS")); value != "" {
+		freshness, err := time.ParseDuration(value)
+		if err != nil || freshness < 0 {
+			return nil, fmt.Errorf("invalid PRICE_FRESHNESS %q", value)
+		}
+		cfg.Freshness = freshness
+	}
+
+	return cfg, nil
+}
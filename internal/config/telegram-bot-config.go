@@ -0,0 +1,63 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type TelegramBotConfig struct {
+	Enabled  bool
+	BotToken string
+	// AllowedChatIDs may run commands, every other chat is refused
+	AllowedChatIDs []int64
+	// PollTimeout is how long a getUpdates long poll waits for an update
+	PollTimeout time.Duration
+}
+
+// LoadTelegramBotConfig is opt-in with TELEGRAM_BOT_ENABLED=true. It shares TELEGRAM_BOT_TOKEN
+// with the notifier and only allows TELEGRAM_CHAT_ID unless TELEGRAM_ALLOWED_CHAT_IDS lists others.
+func LoadTelegramBotConfig() (*TelegramBotConfig, error) {
+	cfg := &TelegramBotConfig{
+		Enabled:     os.Getenv("TELEGRAM_BOT_ENABLED") == "true",
+		BotToken:    os.Getenv("TELEGRAM_BOT_TOKEN"),
+		PollTimeout: 30 * time.Second,
+	}
+	if !cfg.Enabled {
+		return cfg, nil
+	}
+
+	allowed := os.Getenv("TELEGRAM_ALLOWED_CHAT_IDS")
+	if strings.TrimSpace(allowed) == "" {
+		allowed = os.Getenv("TELEGRAM_CHAT_ID")
+	}
+	for _, value := range strings.Split(allowed, ",") {
+		if value = strings.TrimSpace(value); value == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chat ID %q in TELEGRAM_ALLOWED_CHAT_IDS", value)
+		}
+		cfg.AllowedChatIDs = append(cfg.AllowedChatIDs, id)
+	}
+
+	if value := strings.TrimSpace(os.Getenv("TELEGRAM_BOT_POLL_TIMEOUT")); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout < time.Second || timeout > 50*time.Second {
+			return nil, fmt.Errorf("invalid TELEGRAM_BOT_POLL_TIMEOUT %q, expected between 1s and 50s", value)
+		}
+		cfg.PollTimeout = timeout
+	}
+
+	if cfg.BotToken == "" {
+		return nil, fmt.Errorf("incomplete Telegram bot config: TELEGRAM_BOT_TOKEN is required when TELEGRAM_BOT_ENABLED=true")
+	}
+	if len(cfg.AllowedChatIDs) == 0 {
+		return nil, fmt.Errorf("incomplete Telegram bot config: TELEGRAM_ALLOWED_CHAT_IDS or TELEGRAM_CHAT_ID is required when TELEGRAM_BOT_ENABLED=true")
+	}
+
+	return cfg, nil
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 )
 
@@ -10,6 +11,9 @@ type VantageConfig struct {
 	ApiKey        string
 	ApiKeyBackups []string
 	BaseUrl       string
+	// DailyLimit and MinuteLimit are the request quotas of a single API key
+	DailyLimit  int
+	MinuteLimit int
 }
 
 func LoadVantageConfig() (*VantageConfig, error) {
@@ -17,25 +21,57 @@ func LoadVantageConfig() (*VantageConfig, error) {
 		ApiKey: strings.TrimSpace(os.Getenv("ALPHA_VANTAGE_API_KEY")),
 		// get ALPHA_VANTAGE_API_KEY_BACKUP which is a comma-separated list of API keys
 		ApiKeyBackups: func() []string {
-			keys := strings.Split(os.Getenv("ALPHA_VANTAGE_API_KEY_BACKUP"), ",")
-			for i := range keys {
-				keys[i] = strings.TrimSpace(keys[i])
+			var keys []string
+			for _, key := range strings.Split(os.Getenv("ALPHA_VANTAGE_API_KEY_BACKUP"), ",") {
+				if key = strings.TrimSpace(key); key != "" {
+					keys = append(keys, key)
+				}
 			}
 			return keys
 		}(),
 		BaseUrl: strings.TrimSpace(os.Getenv("ALPHA_VANTAGE_BASE_URL")),
+		// free tier quotas
+		DailyLimit:  25,
+		MinuteLimit: 5,
 	}
 
 	if cfg.ApiKey == "" || cfg.BaseUrl == "" {
 		return nil, fmt.Errorf("incomplete Vantage config")
 	}
 
+	if value := strings.TrimSpace(os.Getenv("ALPHA_VANTAGE_DAILY_LIMIT")); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid ALPHA_VANTAGE_DAILY_LIMIT %q", value)
+		}
+		cfg.DailyLimit = limit
+	}
+	if value := strings.TrimSpace(os.Getenv("ALPHA_VANTAGE_MINUTE_LIMIT")); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid ALPHA_VANTAGE_MINUTE_LIMIT %q", value)
+		}
+		cfg.MinuteLimit = limit
+	}
+
 	return cfg, nil
 }
 
+// ApiKeys returns the primary key followed by the backups
+func (c *VantageConfig) ApiKeys() []string {
+	return append([]string{c.ApiKey}, c.ApiKeyBackups...)
+}
+
 func (c *VantageConfig) GetGlobalQuoteUrl(symbol string, apiKey string) string {
 	return fmt.Sprintf(
 		"%s/query?function=GLOBAL_QUOTE&symbol=%s&apikey=%s",
 		c.BaseUrl, symbol, apiKey,
 	)
 }
+
+func (c *VantageConfig) GetDailySeriesUrl(symbol string, apiKey string) string {
+	return fmt.Sprintf(
+		"%s/query?function=TIME_SERIES_DAILY&symbol=%s&outputsize=compact&apikey=%s",
+		c.BaseUrl, symbol, apiKey,
+	)
+}
@@ -0,0 +1,39 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/khorzhenwin/gold-digger/internal/indicators"
+	"github.com/khorzhenwin/gold-digger/internal/market-data"
+	price_alert "github.com/khorzhenwin/gold-digger/internal/price-alert"
+	ticker_price "github.com/khorzhenwin/gold-digger/internal/ticker-price"
+	"github.com/khorzhenwin/gold-digger/internal/watchlist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// toStatus maps service errors onto gRPC codes. The gateway turns the same codes into HTTP
+// statuses, so both transports report a given failure identically.
+func toStatus(err error, internalMessage string) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, gorm.ErrRecordNotFound) || strings.Contains(err.Error(), "no record found"):
+		return status.Error(codes.NotFound, "not found")
+	case errors.Is(err, indicators.ErrUnknownIndicator) || errors.Is(err, ticker_price.ErrTooManyBuckets) ||
+		errors.Is(err, watchlist.ErrInvalidAlertConfig) || errors.Is(err, watchlist.ErrUnknownExchange) ||
+		errors.Is(err, watchlist.ErrInvalidSessionMode) || errors.Is(err, price_alert.ErrInvalidPriceAlert):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, market_data.ErrRateLimited) || errors.Is(err, market_data.ErrNoApiKeyAvailable):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	default:
+		return status.Error(codes.Internal, internalMessage)
+	}
+}
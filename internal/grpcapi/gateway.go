@@ -0,0 +1,89 @@
+package grpcapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	golddiggerv1 "github.com/khorzhenwin/gold-digger/gen/proto/golddigger/v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// protoBasePath is the prefix every google.api.http annotation in api.proto uses
+const protoBasePath = "/api/v1"
+
+type gatewayError struct {
+	Code    int    `json:"code"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// NewGateway serves the proto's REST annotations in-process, calling the same servers as gRPC.
+// Requests under basePath are rewritten onto the /api/v1 paths declared in the proto.
+func NewGateway(ctx context.Context, services *Services, basePath string) (http.Handler, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true,
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+		runtime.WithErrorHandler(writeGatewayError),
+	)
+
+	if err := golddiggerv1.RegisterHealthServiceHandlerServer(ctx, mux, services.Health); err != nil {
+		return nil, err
+	}
+	if err := golddiggerv1.RegisterTickerPriceServiceHandlerServer(ctx, mux, services.TickerPrice); err != nil {
+		return nil, err
+	}
+	if err := golddiggerv1.RegisterIndicatorServiceHandlerServer(ctx, mux, services.Indicator); err != nil {
+		return nil, err
+	}
+	if err := golddiggerv1.RegisterSignalServiceHandlerServer(ctx, mux, services.Signal); err != nil {
+		return nil, err
+	}
+	if err := golddiggerv1.RegisterWatchlistServiceHandlerServer(ctx, mux, services.Watchlist); err != nil {
+		return nil, err
+	}
+
+	if basePath == protoBasePath {
+		return mux, nil
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rewritten := r.Clone(r.Context())
+		rewritten.URL.Path = protoBasePath + strings.TrimPrefix(r.URL.Path, basePath)
+		if r.URL.RawPath != "" {
+			rewritten.URL.RawPath = protoBasePath + strings.TrimPrefix(r.URL.RawPath, basePath)
+		}
+		mux.ServeHTTP(w, rewritten)
+	}), nil
+}
+
+// GatewayOpenAPI rewrites the generated spec's paths onto the gateway's base path
+func GatewayOpenAPI(spec []byte, basePath string) []byte {
+	if basePath == protoBasePath {
+		return spec
+	}
+	return []byte(strings.ReplaceAll(string(spec), `"`+protoBasePath+`/`, `"`+basePath+`/`))
+}
+
+func writeGatewayError(_ context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, _ *http.Request, err error) {
+	st := status.Convert(err)
+	httpStatus := runtime.HTTPStatusFromCode(st.Code())
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_ = json.NewEncoder(w).Encode(gatewayError{
+		Code:    httpStatus,
+		Status:  st.Code().String(),
+		Message: st.Message(),
+	})
+}
@@ -2,17 +2,41 @@ package grpcapi
 
 import (
 	golddiggerv1 "github.com/khorzhenwin/gold-digger/gen/proto/golddigger/v1"
+	"github.com/khorzhenwin/gold-digger/internal/indicators"
+	price_alert "github.com/khorzhenwin/gold-digger/internal/price-alert"
+	"github.com/khorzhenwin/gold-digger/internal/signals"
 	ticker_price "github.com/khorzhenwin/gold-digger/internal/ticker-price"
 	"github.com/khorzhenwin/gold-digger/internal/watchlist"
 	"google.golang.org/grpc"
 )
 
-func NewServer(watchlistService *watchlist.Service, tickerPriceService *ticker_price.Service) *grpc.Server {
+// Services are the RPC implementations shared by the gRPC server and the REST gateway
+type Services struct {
+	Health      *HealthServer
+	TickerPrice *TickerPriceServer
+	Indicator   *IndicatorServer
+	Signal      *SignalServer
+	Watchlist   *WatchlistServer
+}
+
+func NewServices(watchlistService *watchlist.Service, tickerPriceService *ticker_price.Service, indicatorService *indicators.Service, signalService *signals.Service, priceAlertService *price_alert.Service) *Services {
+	return &Services{
+		Health:      &HealthServer{},
+		TickerPrice: NewTickerPriceServer(tickerPriceService),
+		Indicator:   NewIndicatorServer(indicatorService),
+		Signal:      NewSignalServer(signalService),
+		Watchlist:   NewWatchlistServer(watchlistService, priceAlertService),
+	}
+}
+
+func NewServer(services *Services) *grpc.Server {
 	server := grpc.NewServer()
 
-	golddiggerv1.RegisterHealthServiceServer(server, &HealthServer{})
-	golddiggerv1.RegisterTickerPriceServiceServer(server, NewTickerPriceServer(tickerPriceService))
-	golddiggerv1.RegisterWatchlistServiceServer(server, NewWatchlistServer(watchlistService))
+	golddiggerv1.RegisterHealthServiceServer(server, services.Health)
+	golddiggerv1.RegisterTickerPriceServiceServer(server, services.TickerPrice)
+	golddiggerv1.RegisterIndicatorServiceServer(server, services.Indicator)
+	golddiggerv1.RegisterSignalServiceServer(server, services.Signal)
+	golddiggerv1.RegisterWatchlistServiceServer(server, services.Watchlist)
 
 	return server
 }
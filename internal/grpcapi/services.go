@@ -2,18 +2,21 @@ package grpcapi
 
 import (
 	"context"
-	"errors"
 	"strings"
+	"time"
 
 	golddiggerv1 "github.com/khorzhenwin/gold-digger/gen/proto/golddigger/v1"
+	"github.com/khorzhenwin/gold-digger/internal/indicators"
 	"github.com/khorzhenwin/gold-digger/internal/models"
+	price_alert "github.com/khorzhenwin/gold-digger/internal/price-alert"
+	"github.com/khorzhenwin/gold-digger/internal/signals"
 	ticker_price "github.com/khorzhenwin/gold-digger/internal/ticker-price"
 	"github.com/khorzhenwin/gold-digger/internal/watchlist"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
-	"gorm.io/gorm"
 )
 
 type HealthServer struct {
@@ -38,12 +41,12 @@ func NewTickerPriceServer(service *ticker_price.Service) *TickerPriceServer {
 	return &TickerPriceServer{service: service}
 }
 
-func (s *TickerPriceServer) GetTickerPrice(_ context.Context, req *golddiggerv1.GetTickerPriceRequest) (*golddiggerv1.TickerPrice, error) {
+func (s *TickerPriceServer) GetTickerPrice(ctx context.Context, req *golddiggerv1.GetTickerPriceRequest) (*golddiggerv1.TickerPrice, error) {
 	if strings.TrimSpace(req.GetTicker()) == "" {
 		return nil, status.Error(codes.InvalidArgument, "ticker is required")
 	}
 
-	tickerPrice := s.service.FindBySymbol(req.GetTicker())
+	tickerPrice := s.service.GetLatestPrice(ctx, req.GetTicker())
 	if tickerPrice == nil {
 		return nil, status.Error(codes.NotFound, "ticker not found")
 	}
@@ -52,16 +55,207 @@ func (s *TickerPriceServer) GetTickerPrice(_ context.Context, req *golddiggerv1.
 		Symbol:    tickerPrice.Symbol,
 		Price:     tickerPrice.Price,
 		Timestamp: timestamppb.New(tickerPrice.Timestamp),
+		Source:    tickerPrice.Source,
+		AsOf:      timestamppb.New(tickerPrice.AsOf),
+		Session:   tickerPrice.Session,
 	}, nil
 }
 
+func (s *TickerPriceServer) GetTickerPriceHistory(_ context.Context, req *golddiggerv1.GetTickerPriceHistoryRequest) (*golddiggerv1.GetTickerPriceHistoryResponse, error) {
+	if strings.TrimSpace(req.GetTicker()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "ticker is required")
+	}
+
+	from, to, err := timeRange(req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := ticker_price.ParseInterval(req.GetInterval())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	history, err := s.service.GetHistory(req.GetTicker(), from, to, interval)
+	if err != nil {
+		return nil, toStatus(err, "failed to retrieve price history")
+	}
+
+	prices := make([]*golddiggerv1.TickerPrice, 0, len(history.Prices))
+	for _, p := range history.Prices {
+		prices = append(prices, mapTickerPriceToProto(p))
+	}
+
+	candles := make([]*golddiggerv1.PriceCandle, 0, len(history.Candles))
+	for _, c := range history.Candles {
+		candles = append(candles, &golddiggerv1.PriceCandle{
+			Bucket: timestamppb.New(c.Bucket),
+			Open:   c.Open,
+			High:   c.High,
+			Low:    c.Low,
+			Close:  c.Close,
+			Volume: c.Volume,
+			Count:  c.Count,
+		})
+	}
+
+	return &golddiggerv1.GetTickerPriceHistoryResponse{
+		Ticker:   history.Ticker,
+		Interval: history.Interval,
+		Prices:   prices,
+		Candles:  candles,
+	}, nil
+}
+
+// maxStreamReplay bounds how many stored prices per ticker a stream may replay
+const maxStreamReplay = 500
+
+func (s *TickerPriceServer) StreamTickerPrices(req *golddiggerv1.StreamTickerPricesRequest, stream grpc.ServerStreamingServer[golddiggerv1.TickerPrice]) error {
+	symbols := make(map[string]struct{}, len(req.GetTickers()))
+	for _, ticker := range req.GetTickers() {
+		if symbol := strings.TrimSpace(ticker); symbol != "" {
+			symbols[symbol] = struct{}{}
+		}
+	}
+	if len(symbols) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one ticker is required")
+	}
+	if req.GetReplay() < 0 || req.GetReplay() > maxStreamReplay {
+		return status.Errorf(codes.InvalidArgument, "replay must be between 0 and %d", maxStreamReplay)
+	}
+
+	// subscribe before replaying so no price persisted in between is missed
+	sub := s.service.SubscribePrices("grpc-stream")
+	defer s.service.UnsubscribePrices(sub)
+
+	replayedUntil := make(map[string]time.Time, len(symbols))
+	if req.GetReplay() > 0 {
+		for symbol := range symbols {
+			prices, err := s.service.GetRecentPrices(symbol, int(req.GetReplay()))
+			if err != nil {
+				return toStatus(err, "failed to replay prices")
+			}
+			for _, p := range prices {
+				if err := stream.Send(mapTickerPriceToProto(p)); err != nil {
+					return err
+				}
+				replayedUntil[symbol] = p.Timestamp
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case p, ok := <-sub.C():
+			if !ok {
+				// the poller stopped, let the client reconnect elsewhere
+				return status.Error(codes.Unavailable, "price stream closed")
+			}
+			if _, wanted := symbols[p.Symbol]; !wanted {
+				continue
+			}
+			if !p.Timestamp.After(replayedUntil[p.Symbol]) {
+				continue
+			}
+			if err := stream.Send(mapTickerPriceToProto(p)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+type IndicatorServer struct {
+	golddiggerv1.UnimplementedIndicatorServiceServer
+	service *indicators.Service
+}
+
+func NewIndicatorServer(service *indicators.Service) *IndicatorServer {
+	return &IndicatorServer{service: service}
+}
+
+func (s *IndicatorServer) GetIndicatorSeries(_ context.Context, req *golddiggerv1.GetIndicatorSeriesRequest) (*golddiggerv1.IndicatorSeries, error) {
+	if strings.TrimSpace(req.GetTicker()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "ticker is required")
+	}
+
+	from, to, err := timeRange(req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := s.service.GetSeries(req.GetTicker(), req.GetIndicator(), from, to, indicators.Params{
+		Period:       int(req.GetPeriod()),
+		FastPeriod:   int(req.GetFastPeriod()),
+		SlowPeriod:   int(req.GetSlowPeriod()),
+		SignalPeriod: int(req.GetSignalPeriod()),
+		StdDev:       req.GetStdDev(),
+	})
+	if err != nil {
+		return nil, toStatus(err, "failed to compute indicator")
+	}
+
+	items := make([]*golddiggerv1.IndicatorPoint, 0, len(points))
+	for _, p := range points {
+		items = append(items, &golddiggerv1.IndicatorPoint{
+			Timestamp: timestamppb.New(p.Timestamp),
+			Values:    p.Values,
+		})
+	}
+
+	return &golddiggerv1.IndicatorSeries{
+		Ticker:    req.GetTicker(),
+		Indicator: req.GetIndicator(),
+		Points:    items,
+	}, nil
+}
+
+type SignalServer struct {
+	golddiggerv1.UnimplementedSignalServiceServer
+	service *signals.Service
+}
+
+func NewSignalServer(service *signals.Service) *SignalServer {
+	return &SignalServer{service: service}
+}
+
+func (s *SignalServer) ListSignals(_ context.Context, req *golddiggerv1.ListSignalsRequest) (*golddiggerv1.ListSignalsResponse, error) {
+	from, to, err := timeRange(req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	}
+
+	found, err := s.service.Find(signals.Filter{
+		Symbol:   req.GetSymbol(),
+		Strategy: req.GetStrategy(),
+		From:     from,
+		To:       to,
+		Limit:    int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, toStatus(err, "failed to retrieve signals")
+	}
+
+	items := make([]*golddiggerv1.Signal, 0, len(found))
+	for _, signal := range found {
+		items = append(items, mapSignalToProto(signal))
+	}
+
+	return &golddiggerv1.ListSignalsResponse{Signals: items}, nil
+}
+
 type WatchlistServer struct {
 	golddiggerv1.UnimplementedWatchlistServiceServer
-	service *watchlist.Service
+	service           *watchlist.Service
+	priceAlertService *price_alert.Service
 }
 
-func NewWatchlistServer(service *watchlist.Service) *WatchlistServer {
-	return &WatchlistServer{service: service}
+func NewWatchlistServer(service *watchlist.Service, priceAlertService *price_alert.Service) *WatchlistServer {
+	return &WatchlistServer{service: service, priceAlertService: priceAlertService}
 }
 
 func (s *WatchlistServer) ListWatchlist(context.Context, *golddiggerv1.ListWatchlistRequest) (*golddiggerv1.ListWatchlistResponse, error) {
@@ -84,12 +278,15 @@ func (s *WatchlistServer) CreateWatchlistItem(_ context.Context, req *golddigger
 	}
 
 	ticker := models.Ticker{
-		Symbol: req.GetTicker().GetSymbol(),
-		Notes:  req.GetTicker().GetNotes(),
+		Symbol:      req.GetTicker().GetSymbol(),
+		Exchange:    req.GetTicker().GetExchange(),
+		SessionMode: req.GetTicker().GetSessionMode(),
+		Notes:       req.GetTicker().GetNotes(),
+		Alert:       mapAlertConfigFromProto(req.GetTicker().GetAlert()),
 	}
 
 	if err := s.service.CreateTicker(&ticker); err != nil {
-		return nil, status.Error(codes.Internal, "failed to create ticker")
+		return nil, toStatus(err, "failed to create ticker")
 	}
 
 	return &golddiggerv1.OperationStatus{Message: "created"}, nil
@@ -104,14 +301,14 @@ func (s *WatchlistServer) UpdateWatchlistItem(_ context.Context, req *golddigger
 	}
 
 	err := s.service.UpdateTicker(uint(req.GetId()), models.Ticker{
-		Symbol: req.GetTicker().GetSymbol(),
-		Notes:  req.GetTicker().GetNotes(),
+		Symbol:      req.GetTicker().GetSymbol(),
+		Exchange:    req.GetTicker().GetExchange(),
+		SessionMode: req.GetTicker().GetSessionMode(),
+		Notes:       req.GetTicker().GetNotes(),
+		Alert:       mapAlertConfigFromProto(req.GetTicker().GetAlert()),
 	})
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Error(codes.NotFound, "ticker not found")
-		}
-		return nil, status.Error(codes.Internal, "failed to update ticker")
+		return nil, toStatus(err, "failed to update ticker")
 	}
 
 	return &golddiggerv1.OperationStatus{Message: "updated"}, nil
@@ -123,21 +320,190 @@ func (s *WatchlistServer) DeleteWatchlistItem(_ context.Context, req *golddigger
 	}
 
 	if err := s.service.DeleteTicker(uint(req.GetId())); err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) || strings.Contains(err.Error(), "no record found") {
-			return nil, status.Error(codes.NotFound, "ticker not found")
-		}
-		return nil, status.Error(codes.Internal, "failed to delete ticker")
+		return nil, toStatus(err, "failed to delete ticker")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *WatchlistServer) ListPriceAlerts(_ context.Context, req *golddiggerv1.ListPriceAlertsRequest) (*golddiggerv1.ListPriceAlertsResponse, error) {
+	if req.GetTickerId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticker_id is required")
+	}
+
+	alerts, err := s.priceAlertService.FindByTicker(uint(req.GetTickerId()))
+	if err != nil {
+		return nil, toStatus(err, "failed to retrieve alerts")
+	}
+
+	items := make([]*golddiggerv1.PriceAlert, 0, len(alerts))
+	for _, alert := range alerts {
+		items = append(items, mapPriceAlertToProto(alert))
+	}
+
+	return &golddiggerv1.ListPriceAlertsResponse{Alerts: items}, nil
+}
+
+func (s *WatchlistServer) CreatePriceAlert(_ context.Context, req *golddiggerv1.CreatePriceAlertRequest) (*golddiggerv1.PriceAlert, error) {
+	if req.GetTickerId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticker_id is required")
+	}
+	if req.GetAlert() == nil {
+		return nil, status.Error(codes.InvalidArgument, "alert is required")
+	}
+
+	alert := mapPriceAlertFromProto(req.GetAlert())
+	if err := s.priceAlertService.Create(uint(req.GetTickerId()), &alert); err != nil {
+		return nil, toStatus(err, "failed to create alert")
+	}
+
+	return mapPriceAlertToProto(alert), nil
+}
+
+func (s *WatchlistServer) UpdatePriceAlert(_ context.Context, req *golddiggerv1.UpdatePriceAlertRequest) (*golddiggerv1.PriceAlert, error) {
+	if req.GetTickerId() == 0 || req.GetAlertId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticker_id and alert_id are required")
+	}
+	if req.GetAlert() == nil {
+		return nil, status.Error(codes.InvalidArgument, "alert is required")
+	}
+
+	updated, err := s.priceAlertService.Update(uint(req.GetTickerId()), uint(req.GetAlertId()), mapPriceAlertFromProto(req.GetAlert()))
+	if err != nil {
+		return nil, toStatus(err, "failed to update alert")
+	}
+
+	return mapPriceAlertToProto(*updated), nil
+}
+
+func (s *WatchlistServer) DeletePriceAlert(_ context.Context, req *golddiggerv1.DeletePriceAlertRequest) (*emptypb.Empty, error) {
+	if req.GetTickerId() == 0 || req.GetAlertId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticker_id and alert_id are required")
+	}
+
+	if err := s.priceAlertService.Delete(uint(req.GetTickerId()), uint(req.GetAlertId())); err != nil {
+		return nil, toStatus(err, "failed to delete alert")
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+// timeRange applies the same defaults as the REST handlers, the 7 days up to now
+func timeRange(fromTs *timestamppb.Timestamp, toTs *timestamppb.Timestamp) (time.Time, time.Time, error) {
+	to := time.Now()
+	if toTs != nil {
+		to = toTs.AsTime()
+	}
+	from := to.Add(-7 * 24 * time.Hour)
+	if fromTs != nil {
+		from = fromTs.AsTime()
+	}
+	if from.After(to) {
+		return from, to, status.Error(codes.InvalidArgument, "from must be before to")
+	}
+	return from, to, nil
+}
+
 func mapTickerToProto(t models.Ticker) *golddiggerv1.WatchlistItem {
-	return &golddiggerv1.WatchlistItem{
-		Id:        uint64(t.ID),
-		CreatedAt: timestamppb.New(t.CreatedAt),
-		UpdatedAt: timestamppb.New(t.UpdatedAt),
-		Symbol:    t.Symbol,
-		Notes:     t.Notes,
+	item := &golddiggerv1.WatchlistItem{
+		Id:          uint64(t.ID),
+		CreatedAt:   timestamppb.New(t.CreatedAt),
+		UpdatedAt:   timestamppb.New(t.UpdatedAt),
+		Symbol:      t.Symbol,
+		Exchange:    t.Exchange,
+		SessionMode: t.SessionMode,
+		Notes:       t.Notes,
+		Alert: &golddiggerv1.AlertConfig{
+			MinChange:               t.Alert.MinChange,
+			MinSteps:                int32(t.Alert.MinSteps),
+			WindowSize:              int32(t.Alert.WindowSize),
+			CooldownMinutes:         int32(t.Alert.CooldownMinutes),
+			Strategies:              t.Alert.Strategies,
+			ExtendedHoursStrategies: t.Alert.ExtendedHoursStrategies,
+			Muted:                   t.Alert.Muted,
+		},
+	}
+	if t.Alert.MutedUntil != nil {
+		item.Alert.MutedUntil = timestamppb.New(*t.Alert.MutedUntil)
+	}
+	return item
+}
+
+func mapAlertConfigFromProto(alert *golddiggerv1.AlertConfig) models.AlertConfig {
+	config := models.AlertConfig{
+		MinChange:               alert.GetMinChange(),
+		MinSteps:                int(alert.GetMinSteps()),
+		WindowSize:              int(alert.GetWindowSize()),
+		CooldownMinutes:         int(alert.GetCooldownMinutes()),
+		Strategies:              alert.GetStrategies(),
+		ExtendedHoursStrategies: alert.GetExtendedHoursStrategies(),
+		Muted:                   alert.GetMuted(),
+	}
+	if alert.GetMutedUntil() != nil {
+		mutedUntil := alert.GetMutedUntil().AsTime()
+		config.MutedUntil = &mutedUntil
+	}
+	return config
+}
+
+func mapPriceAlertToProto(a models.PriceAlert) *golddiggerv1.PriceAlert {
+	alert := &golddiggerv1.PriceAlert{
+		Id:             uint64(a.ID),
+		TickerId:       uint64(a.TickerID),
+		Symbol:         a.Symbol,
+		Condition:      a.Condition,
+		Level:          a.Level,
+		ReferencePrice: a.ReferencePrice,
+		Repeat:         a.Repeat,
+		Armed:          a.Armed,
+		TriggerCount:   int32(a.TriggerCount),
+		Note:           a.Note,
+		CreatedAt:      timestamppb.New(a.CreatedAt),
+		UpdatedAt:      timestamppb.New(a.UpdatedAt),
+	}
+	if a.TriggeredAt != nil {
+		alert.TriggeredAt = timestamppb.New(*a.TriggeredAt)
+	}
+	return alert
+}
+
+func mapPriceAlertFromProto(alert *golddiggerv1.PriceAlert) models.PriceAlert {
+	return models.PriceAlert{
+		Condition:      alert.GetCondition(),
+		Level:          alert.GetLevel(),
+		ReferencePrice: alert.GetReferencePrice(),
+		Repeat:         alert.GetRepeat(),
+		Note:           alert.GetNote(),
+	}
+}
+
+func mapTickerPriceToProto(p models.TickerPrice) *golddiggerv1.TickerPrice {
+	return &golddiggerv1.TickerPrice{
+		Symbol:    p.Symbol,
+		Price:     p.Price,
+		Timestamp: timestamppb.New(p.Timestamp),
+		Session:   p.Session,
+	}
+}
+
+func mapSignalToProto(s models.Signal) *golddiggerv1.Signal {
+	signal := &golddiggerv1.Signal{
+		Id:          uint64(s.ID),
+		Symbol:      s.Symbol,
+		Strategy:    s.Strategy,
+		Direction:   s.Direction,
+		Strength:    s.Strength,
+		Reason:      s.Reason,
+		Price:       s.Price,
+		ChangePct:   s.ChangePct,
+		WindowStart: timestamppb.New(s.WindowStart),
+		WindowEnd:   timestamppb.New(s.WindowEnd),
+		Sent:        s.Sent,
+		SendError:   s.SendError,
+		CreatedAt:   timestamppb.New(s.CreatedAt),
+	}
+	if s.SentAt != nil {
+		signal.SentAt = timestamppb.New(*s.SentAt)
 	}
+	return signal
 }
@@ -0,0 +1,105 @@
+package indicators
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/khorzhenwin/gold-digger/internal/ticker-price"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type Handler struct {
+	Service Service
+}
+
+type SeriesResponse struct {
+	Ticker    string  `json:"ticker"`
+	Indicator string  `json:"indicator"`
+	Points    []Point `json:"points"`
+}
+
+func RegisterRoutes(r chi.Router, service *Service) {
+	h := &Handler{Service: *service}
+
+	r.Route("/indicators", func(r chi.Router) {
+		r.Get("/{ticker}/{indicator}", h.GetSeriesHandler)
+	})
+}
+
+// GetSeriesHandler handles GET /indicators/{ticker}/{indicator}
+// @Summary      Get an indicator series for a ticker
+// @Description  Computes sma, ema, rsi, macd, bollinger, atr or vwap over stored prices. Defaults to the last 7 days.
+// @Tags         indicators
+// @Produce      json
+// @Param        ticker     path   string  true   "Ticker Symbol"
+// @Param        indicator  path   string  true   "Indicator name"
+// @Param        from       query  string  false  "Start time (RFC3339)"
+// @Param        to         query  string  false  "End time (RFC3339)"
+// @Param        period     query  int     false  "Lookback period"
+// @Param        fast       query  int     false  "MACD fast period"
+// @Param        slow       query  int     false  "MACD slow period"
+// @Param        signal     query  int     false  "MACD signal period"
+// @Param        stddev     query  number  false  "Bollinger standard deviations"
+// @Success      200  {object}  SeriesResponse
+// @Failure      400  {string}  string  "bad request"
+// @Router       /api/v1/indicators/{ticker}/{indicator} [get]
+func (h *Handler) GetSeriesHandler(w http.ResponseWriter, r *http.Request) {
+	tickerSymbol := chi.URLParam(r, "ticker")
+	indicator := chi.URLParam(r, "indicator")
+
+	query := r.URL.Query()
+	from, to, err := ticker_price.ParseTimeRange(query.Get("from"), query.Get("to"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	params, err := parseParams(query)
+	if err != nil {
+		http.Error(w, "Invalid indicator parameters", http.StatusBadRequest)
+		return
+	}
+
+	points, err := h.Service.GetSeries(tickerSymbol, indicator, from, to, params)
+	if err != nil {
+		if errors.Is(err, ErrUnknownIndicator) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Failed to compute indicator", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(SeriesResponse{Ticker: tickerSymbol, Indicator: indicator, Points: points})
+	if err != nil {
+		return
+	}
+}
+
+func parseParams(query url.Values) (Params, error) {
+	var (
+		params Params
+		err    error
+	)
+	for key, target := range map[string]*int{
+		"period": &params.Period,
+		"fast":   &params.FastPeriod,
+		"slow":   &params.SlowPeriod,
+		"signal": &params.SignalPeriod,
+	} {
+		if value := query.Get(key); value != "" {
+			if *target, err = strconv.Atoi(value); err != nil {
+				return params, err
+			}
+		}
+	}
+	if value := query.Get("stddev"); value != "" {
+		if params.StdDev, err = strconv.ParseFloat(value, 64); err != nil {
+			return params, err
+		}
+	}
+	return params, nil
+}
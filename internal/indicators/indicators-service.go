@@ -0,0 +1,24 @@
+package indicators
+
+import (
+	"github.com/khorzhenwin/gold-digger/internal/ticker-price"
+	"time"
+)
+
+type Service struct {
+	tickerPriceRepository *ticker_price.Repository
+}
+
+func NewService(tickerPriceRepository *ticker_price.Repository) *Service {
+	return &Service{tickerPriceRepository: tickerPriceRepository}
+}
+
+// GetSeries computes indicator over the stored prices of symbol between from and to
+func (s *Service) GetSeries(symbol string, indicator string, from time.Time, to time.Time, params Params) ([]Point, error) {
+	prices, err := s.tickerPriceRepository.GetRange(symbol, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return Compute(indicator, prices, params)
+}
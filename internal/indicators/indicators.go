@@ -0,0 +1,335 @@
+package indicators
+
+import (
+	"errors"
+	"fmt"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"math"
+	"strings"
+	"time"
+)
+
+const (
+	IndicatorSMA       = "sma"
+	IndicatorEMA       = "ema"
+	IndicatorRSI       = "rsi"
+	IndicatorMACD      = "macd"
+	IndicatorBollinger = "bollinger"
+	IndicatorATR       = "atr"
+	IndicatorVWAP      = "vwap"
+)
+
+var ErrUnknownIndicator = errors.New("unknown indicator")
+
+// Point is one value of an indicator series. Single line indicators use their own name as the key,
+// MACD uses macd/signal/histogram and Bollinger Bands use upper/middle/lower.
+type Point struct {
+	Timestamp time.Time          `json:"timestamp"`
+	Values    map[string]float64 `json:"values"`
+}
+
+// Params tunes an indicator, zero values fall back to the usual defaults
+type Params struct {
+	Period       int     // SMA 20, EMA 20, RSI 14, Bollinger 20, ATR 14
+	FastPeriod   int     // MACD 12
+	SlowPeriod   int     // MACD 26
+	SignalPeriod int     // MACD 9
+	StdDev       float64 // Bollinger 2
+}
+
+func Names() []string {
+	return []string{IndicatorSMA, IndicatorEMA, IndicatorRSI, IndicatorMACD, IndicatorBollinger, IndicatorATR, IndicatorVWAP}
+}
+
+// Compute dispatches to the indicator called name. Prices must be ordered oldest first.
+func Compute(name string, prices []models.TickerPrice, params Params) ([]Point, error) {
+	switch strings.ToLower(name) {
+	case IndicatorSMA:
+		return SMA(prices, withDefault(params.Period, 20)), nil
+	case IndicatorEMA:
+		return EMA(prices, withDefault(params.Period, 20)), nil
+	case IndicatorRSI:
+		return RSI(prices, withDefault(params.Period, 14)), nil
+	case IndicatorMACD:
+		return MACD(prices, withDefault(params.FastPeriod, 12), withDefault(params.SlowPeriod, 26), withDefault(params.SignalPeriod, 9)), nil
+	case IndicatorBollinger:
+		stdDev := params.StdDev
+		if stdDev <= 0 {
+			stdDev = 2
+		}
+		return BollingerBands(prices, withDefault(params.Period, 20), stdDev), nil
+	case IndicatorATR:
+		return ATR(prices, withDefault(params.Period, 14)), nil
+	case IndicatorVWAP:
+		return VWAP(prices), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownIndicator, name)
+	}
+}
+
+// SMA is the simple moving average of the last period prices
+func SMA(prices []models.TickerPrice, period int) []Point {
+	values := sma(closes(prices), period)
+	return toPoints(prices, IndicatorSMA, values)
+}
+
+// EMA is the exponential moving average, seeded with the SMA of the first period prices
+func EMA(prices []models.TickerPrice, period int) []Point {
+	values := ema(closes(prices), period)
+	return toPoints(prices, IndicatorEMA, values)
+}
+
+// RSI is the relative strength index using Wilder's smoothing
+func RSI(prices []models.TickerPrice, period int) []Point {
+	if period <= 0 || len(prices) <= period {
+		return nil
+	}
+
+	values := make([]float64, len(prices))
+	fillNaN(values)
+
+	var gain, loss float64
+	for i := 1; i <= period; i++ {
+		change := prices[i].Price - prices[i-1].Price
+		if change > 0 {
+			gain += change
+		} else {
+			loss -= change
+		}
+	}
+	gain /= float64(period)
+	loss /= float64(period)
+	values[period] = rsiValue(gain, loss)
+
+	for i := period + 1; i < len(prices); i++ {
+		change := prices[i].Price - prices[i-1].Price
+		up, down := 0.0, 0.0
+		if change > 0 {
+			up = change
+		} else {
+			down = -change
+		}
+		gain = (gain*float64(period-1) + up) / float64(period)
+		loss = (loss*float64(period-1) + down) / float64(period)
+		values[i] = rsiValue(gain, loss)
+	}
+
+	return toPoints(prices, IndicatorRSI, values)
+}
+
+// MACD is the fast EMA minus the slow EMA, with a signal EMA and histogram
+func MACD(prices []models.TickerPrice, fastPeriod int, slowPeriod int, signalPeriod int) []Point {
+	series := closes(prices)
+	fast := ema(series, fastPeriod)
+	slow := ema(series, slowPeriod)
+
+	macdLine := make([]float64, len(series))
+	start := -1
+	for i := range series {
+		macdLine[i] = fast[i] - slow[i]
+		if start < 0 && !math.IsNaN(macdLine[i]) {
+			start = i
+		}
+	}
+	if start < 0 {
+		return nil
+	}
+
+	signal := make([]float64, len(series))
+	fillNaN(signal)
+	copy(signal[start:], ema(macdLine[start:], signalPeriod))
+
+	var points []Point
+	for i := range prices {
+		if math.IsNaN(signal[i]) {
+			continue
+		}
+		points = append(points, Point{
+			Timestamp: prices[i].Timestamp,
+			Values: map[string]float64{
+				"macd":      macdLine[i],
+				"signal":    signal[i],
+				"histogram": macdLine[i] - signal[i],
+			},
+		})
+	}
+	return points
+}
+
+// BollingerBands are the SMA plus and minus stdDev population standard deviations
+func BollingerBands(prices []models.TickerPrice, period int, stdDev float64) []Point {
+	series := closes(prices)
+	middle := sma(series, period)
+
+	var points []Point
+	for i := range prices {
+		if math.IsNaN(middle[i]) {
+			continue
+		}
+		variance := 0.0
+		for _, price := range series[i-period+1 : i+1] {
+			variance += (price - middle[i]) * (price - middle[i])
+		}
+		deviation := math.Sqrt(variance/float64(period)) * stdDev
+
+		points = append(points, Point{
+			Timestamp: prices[i].Timestamp,
+			Values: map[string]float64{
+				"upper":  middle[i] + deviation,
+				"middle": middle[i],
+				"lower":  middle[i] - deviation,
+			},
+		})
+	}
+	return points
+}
+
+// ATR is the average true range using Wilder's smoothing. Stored ticks carry a single price,
+// so the true range of a tick is its absolute move from the previous tick.
+func ATR(prices []models.TickerPrice, period int) []Point {
+	if period <= 0 || len(prices) <= period {
+		return nil
+	}
+
+	values := make([]float64, len(prices))
+	fillNaN(values)
+
+	atr := 0.0
+	for i := 1; i <= period; i++ {
+		atr += math.Abs(prices[i].Price - prices[i-1].Price)
+	}
+	atr /= float64(period)
+	values[period] = atr
+
+	for i := period + 1; i < len(prices); i++ {
+		trueRange := math.Abs(prices[i].Price - prices[i-1].Price)
+		atr = (atr*float64(period-1) + trueRange) / float64(period)
+		values[i] = atr
+	}
+
+	return toPoints(prices, IndicatorATR, values)
+}
+
+// VWAP is the volume weighted average price, reset at the start of every day.
+// Providers report the day's cumulative volume on each quote, so each tick is weighted
+// by the volume traded since the previous tick of the same day.
+func VWAP(prices []models.TickerPrice) []Point {
+	var (
+		points         []Point
+		day            string
+		lastVolume     int64
+		priceVolumeSum float64
+		volumeSum      float64
+	)
+
+	for _, price := range prices {
+		currentDay := price.Timestamp.UTC().Format("2006-01-02")
+		if currentDay != day {
+			day = currentDay
+			lastVolume = 0
+			priceVolumeSum = 0
+			volumeSum = 0
+		}
+
+		traded := price.Volume - lastVolume
+		if traded < 0 {
+			traded = price.Volume
+		}
+		lastVolume = price.Volume
+
+		priceVolumeSum += price.Price * float64(traded)
+		volumeSum += float64(traded)
+		if volumeSum == 0 {
+			continue
+		}
+
+		points = append(points, Point{
+			Timestamp: price.Timestamp,
+			Values:    map[string]float64{IndicatorVWAP: priceVolumeSum / volumeSum},
+		})
+	}
+	return points
+}
+
+func sma(series []float64, period int) []float64 {
+	values := make([]float64, len(series))
+	fillNaN(values)
+	if period <= 0 {
+		return values
+	}
+
+	sum := 0.0
+	for i, price := range series {
+		sum += price
+		if i >= period {
+			sum -= series[i-period]
+		}
+		if i >= period-1 {
+			values[i] = sum / float64(period)
+		}
+	}
+	return values
+}
+
+func ema(series []float64, period int) []float64 {
+	values := make([]float64, len(series))
+	fillNaN(values)
+	if period <= 0 || len(series) < period {
+		return values
+	}
+
+	seed := 0.0
+	for _, price := range series[:period] {
+		seed += price
+	}
+	values[period-1] = seed / float64(period)
+
+	multiplier := 2 / float64(period+1)
+	for i := period; i < len(series); i++ {
+		values[i] = (series[i]-values[i-1])*multiplier + values[i-1]
+	}
+	return values
+}
+
+func rsiValue(gain float64, loss float64) float64 {
+	if loss == 0 {
+		return 100
+	}
+	return 100 - 100/(1+gain/loss)
+}
+
+func closes(prices []models.TickerPrice) []float64 {
+	series := make([]float64, len(prices))
+	for i, price := range prices {
+		series[i] = price.Price
+	}
+	return series
+}
+
+// toPoints drops the warm-up values an indicator cannot compute yet
+func toPoints(prices []models.TickerPrice, key string, values []float64) []Point {
+	var points []Point
+	for i, value := range values {
+		if math.IsNaN(value) {
+			continue
+		}
+		points = append(points, Point{
+			Timestamp: prices[i].Timestamp,
+			Values:    map[string]float64{key: value},
+		})
+	}
+	return points
+}
+
+func fillNaN(values []float64) {
+	for i := range values {
+		values[i] = math.NaN()
+	}
+}
+
+func withDefault(value int, fallback int) int {
+	if value <= 0 {
+		return fallback
+	}
+	return value
+}
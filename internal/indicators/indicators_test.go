@@ -0,0 +1,176 @@
+package indicators
+
+import (
+	"errors"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"math"
+	"testing"
+	"time"
+)
+
+var start = time.Date(2026, time.October, 16, 14, 0, 0, 0, time.UTC)
+
+// series turns prices into ticks five minutes apart
+func series(prices ...float64) []models.TickerPrice {
+	ticks := make([]models.TickerPrice, len(prices))
+	for i, price := range prices {
+		ticks[i] = models.TickerPrice{Symbol: "PLTR", Price: price, Timestamp: start.Add(time.Duration(i) * 5 * time.Minute)}
+	}
+	return ticks
+}
+
+// wantPoint is the expected values of the point at tick index
+type wantPoint struct {
+	index  int
+	values map[string]float64
+}
+
+func assertPoints(t *testing.T, got []Point, want []wantPoint) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d points, want %d: %+v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		if timestamp := start.Add(time.Duration(w.index) * 5 * time.Minute); !got[i].Timestamp.Equal(timestamp) {
+			t.Errorf("point %d: got timestamp %s, want tick %d at %s", i, got[i].Timestamp, w.index, timestamp)
+		}
+		for key, value := range w.values {
+			if math.Abs(got[i].Values[key]-value) > 1e-9 {
+				t.Errorf("point %d: got %s %v, want %v", i, key, got[i].Values[key], value)
+			}
+		}
+	}
+}
+
+func TestIndicators(t *testing.T) {
+	tests := []struct {
+		name string
+		got  []Point
+		want []wantPoint
+	}{
+		{
+			name: "SMA",
+			got:  SMA(series(1, 2, 3, 4, 5), 3),
+			want: []wantPoint{{2, map[string]float64{"sma": 2}}, {3, map[string]float64{"sma": 3}}, {4, map[string]float64{"sma": 4}}},
+		},
+		{
+			name: "SMA longer than the series",
+			got:  SMA(series(1, 2), 3),
+		},
+		{
+			name: "EMA seeded with the SMA",
+			got:  EMA(series(2, 4, 6, 8, 12), 3),
+			want: []wantPoint{{2, map[string]float64{"ema": 4}}, {3, map[string]float64{"ema": 6}}, {4, map[string]float64{"ema": 9}}},
+		},
+		{
+			name: "RSI with Wilder's smoothing",
+			got:  RSI(series(10, 11, 12, 11, 13), 3),
+			want: []wantPoint{{3, map[string]float64{"rsi": 100 - 100.0/3}}, {4, map[string]float64{"rsi": 100 - 100.0/6}}},
+		},
+		{
+			name: "RSI without losses",
+			got:  RSI(series(1, 2, 3, 4), 3),
+			want: []wantPoint{{3, map[string]float64{"rsi": 100}}},
+		},
+		{
+			name: "RSI needs period changes",
+			got:  RSI(series(1, 2, 3), 3),
+		},
+		{
+			name: "MACD",
+			got:  MACD(series(1, 2, 3, 4, 5, 6), 2, 3, 2),
+			want: []wantPoint{
+				{3, map[string]float64{"macd": 0.5, "signal": 0.5, "histogram": 0}},
+				{4, map[string]float64{"macd": 0.5, "signal": 0.5, "histogram": 0}},
+				{5, map[string]float64{"macd": 0.5, "signal": 0.5, "histogram": 0}},
+			},
+		},
+		{
+			name: "MACD with a turn",
+			got:  MACD(series(10, 12, 11, 15, 9), 2, 3, 2),
+			want: []wantPoint{
+				// fast EMA 11, 11, 41/3, 95/9 from tick 1, slow EMA 11, 13, 11 from tick 2,
+				// so MACD is 0, 2/3, -4/9 and its signal EMA starts at their first two's mean
+				{3, map[string]float64{"macd": 2.0 / 3, "signal": 1.0 / 3, "histogram": 1.0 / 3}},
+				{4, map[string]float64{"macd": -4.0 / 9, "signal": -5.0 / 27, "histogram": -7.0 / 27}},
+			},
+		},
+		{
+			name: "Bollinger Bands",
+			got:  BollingerBands(series(2, 4, 6, 6), 3, 2),
+			want: []wantPoint{
+				{2, map[string]float64{"middle": 4, "upper": 4 + 2*math.Sqrt(8.0/3), "lower": 4 - 2*math.Sqrt(8.0/3)}},
+				{3, map[string]float64{"middle": 16.0 / 3, "upper": 16.0/3 + 2*math.Sqrt(8.0/9), "lower": 16.0/3 - 2*math.Sqrt(8.0/9)}},
+			},
+		},
+		{
+			name: "ATR",
+			got:  ATR(series(10, 12, 11, 14), 2),
+			want: []wantPoint{{2, map[string]float64{"atr": 1.5}}, {3, map[string]float64{"atr": 2.25}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPoints(t, tt.got, tt.want)
+		})
+	}
+}
+
+func TestVWAP(t *testing.T) {
+	ticks := series(10, 12, 11, 20, 22)
+	// cumulative day volumes, the first tick has not traded yet and the fourth starts a new day
+	volumes := []int64{0, 100, 300, 50, 150}
+	for i := range ticks {
+		ticks[i].Volume = volumes[i]
+	}
+	ticks[3].Timestamp = start.AddDate(0, 0, 1)
+	ticks[4].Timestamp = start.AddDate(0, 0, 1).Add(5 * time.Minute)
+
+	got := VWAP(ticks)
+	want := []float64{12, (12*100 + 11*200) / 300.0, 20, (20*50 + 22*100) / 150.0}
+	if len(got) != len(want) {
+		t.Fatalf("got %d points, want %d: %+v", len(got), len(want), got)
+	}
+	for i, value := range want {
+		if math.Abs(got[i].Values["vwap"]-value) > 1e-9 {
+			t.Errorf("point %d: got vwap %v, want %v", i, got[i].Values["vwap"], value)
+		}
+	}
+}
+
+func TestCompute(t *testing.T) {
+	prices := make([]float64, 25)
+	for i := range prices {
+		prices[i] = float64(i + 1)
+	}
+	ticks := series(prices...)
+
+	tests := []struct {
+		name       string
+		indicator  string
+		params     Params
+		wantPoints int
+		wantErr    error
+	}{
+		{name: "default SMA period of 20", indicator: "SMA", wantPoints: 6},
+		{name: "SMA period", indicator: IndicatorSMA, params: Params{Period: 5}, wantPoints: 21},
+		{name: "default RSI period of 14", indicator: IndicatorRSI, wantPoints: 11},
+		{name: "default MACD needs 26 plus 9 prices", indicator: IndicatorMACD},
+		{name: "default Bollinger period of 20", indicator: IndicatorBollinger, wantPoints: 6},
+		{name: "VWAP without volume", indicator: IndicatorVWAP},
+		{name: "unknown indicator", indicator: "ichimoku", wantErr: ErrUnknownIndicator},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points, err := Compute(tt.indicator, ticks, tt.params)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if len(points) != tt.wantPoints {
+				t.Errorf("got %d points, want %d", len(points), tt.wantPoints)
+			}
+		})
+	}
+}
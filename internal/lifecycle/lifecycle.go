@@ -0,0 +1,114 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Manager owns the root context of the application. Workers started with Go receive it and
+// are expected to return once it is cancelled, after finishing the work they have in flight.
+type Manager struct {
+	ctx             context.Context
+	cancel          context.CancelFunc
+	shutdownTimeout time.Duration
+
+	workers sync.WaitGroup
+	mu      sync.Mutex
+	hooks   []hook
+	err     error
+}
+
+type hook struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// NewManager cancels the root context on SIGINT or SIGTERM
+func NewManager(shutdownTimeout time.Duration) *Manager {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	return &Manager{ctx: ctx, cancel: cancel, shutdownTimeout: shutdownTimeout}
+}
+
+func (m *Manager) Context() context.Context {
+	return m.ctx
+}
+
+// Go runs a worker. A worker failing with anything but a cancellation shuts the application down.
+func (m *Manager) Go(name string, fn func(ctx context.Context) error) {
+	m.workers.Add(1)
+	go func() {
+		defer m.workers.Done()
+
+		err := fn(m.ctx)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			log.Printf("❌ %s stopped: %v", name, err)
+			m.fail(err)
+			return
+		}
+		log.Printf("🛑 %s stopped", name)
+	}()
+}
+
+// OnShutdown registers a hook run once the root context is cancelled, such as stopping a server.
+// Hooks run in reverse registration order, bounded by the shutdown timeout.
+func (m *Manager) OnShutdown(name string, fn func(ctx context.Context) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hooks = append(m.hooks, hook{name: name, fn: fn})
+}
+
+// Shutdown cancels the root context, as a signal would
+func (m *Manager) Shutdown() {
+	m.cancel()
+}
+
+// Wait blocks until shutdown is requested, then runs the hooks and waits for every worker to drain.
+// It returns the first worker error, if any.
+func (m *Manager) Wait() error {
+	<-m.ctx.Done()
+	log.Printf("🧹 Shutting down, waiting up to %s for in-flight work", m.shutdownTimeout)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), m.shutdownTimeout)
+	defer cancel()
+
+	m.mu.Lock()
+	hooks := m.hooks
+	m.mu.Unlock()
+	for i := len(hooks) - 1; i >= 0; i-- {
+		if err := hooks[i].fn(shutdownCtx); err != nil {
+			log.Printf("⚠️ Shutdown of %s failed: %v", hooks[i].name, err)
+		}
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		m.workers.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		log.Println("✅ Shutdown complete")
+	case <-shutdownCtx.Done():
+		log.Println("⚠️ Shutdown timed out before every worker drained")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.err
+}
+
+func (m *Manager) fail(err error) {
+	m.mu.Lock()
+	if m.err == nil {
+		m.err = err
+	}
+	m.mu.Unlock()
+	m.cancel()
+}
@@ -0,0 +1,274 @@
+package market_data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/khorzhenwin/gold-digger/internal/calendar"
+	"github.com/khorzhenwin/gold-digger/internal/config"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type AlphaVantageProvider struct {
+	vantageConfig config.VantageConfig
+	keyPool       *KeyPool
+	client        *http.Client
+}
+
+func NewAlphaVantageProvider(vantageConfig *config.VantageConfig) *AlphaVantageProvider {
+	return &AlphaVantageProvider{
+		vantageConfig: *vantageConfig,
+		keyPool:       NewKeyPool(vantageConfig.ApiKeys(), vantageConfig.DailyLimit, vantageConfig.MinuteLimit),
+		client:        &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+func (p *AlphaVantageProvider) Name() string {
+	return ProviderAlphaVantage
+}
+
+func (p *AlphaVantageProvider) GetQuote(ctx context.Context, symbol string, exchange *calendar.Exchange) (*models.TickerPrice, error) {
+	if exchange == nil {
+		return nil, fmt.Errorf("no exchange given for %s", symbol)
+	}
+	raw, err := p.query(ctx, func(apiKey string) string {
+		return p.vantageConfig.GetGlobalQuoteUrl(symbol, apiKey)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the nested quote safely
+	globalQuote, ok := raw["Global Quote"].(map[string]interface{})
+	if !ok || len(globalQuote) == 0 {
+		formatErr := fmt.Errorf("⚠️ Missing or invalid Global Quote: %v", raw)
+		if raw["Information"] != nil {
+			formatErr = fmt.Errorf("❌ API error: %v", raw["Information"])
+		}
+		return nil, formatErr
+	}
+
+	price, _ := globalQuote["05. price"].(string)
+	volume, _ := globalQuote["06. volume"].(string)
+	timestamp, _ := globalQuote["07. latest trading day"].(string)
+
+	if price == "" || timestamp == "" {
+		return nil, fmt.Errorf("empty price or timestamp, skipping symbol %s", symbol)
+	}
+	priceFloat, err := strconv.ParseFloat(price, 64)
+	if err != nil {
+		log.Printf("❌ Failed to parse price for %s: %v", symbol, err)
+		return nil, fmt.Errorf("failed to parse price: %w", err)
+	}
+	parsedTimestamp, err := quoteTimestamp(timestamp, exchange, time.Now())
+	if err != nil {
+		log.Printf("❌ Failed to parse timestamp for %s: %v", symbol, err)
+		return nil, fmt.Errorf("failed to parse timestamp: %w", err)
+	}
+
+	// volume is informational, a missing value should not drop the quote
+	volumeInt, _ := strconv.ParseInt(volume, 10, 64)
+
+	return &models.TickerPrice{
+		Symbol:    symbol,
+		Price:     priceFloat,
+		Volume:    volumeInt,
+		Timestamp: parsedTimestamp,
+	}, nil
+}
+
+// GetQuotes fetches symbols one by one, Alpha Vantage has no batch quote endpoint on the free tier
+func (p *AlphaVantageProvider) GetQuotes(ctx context.Context, symbols []string, exchange *calendar.Exchange) ([]models.TickerPrice, error) {
+	var (
+		prices []models.TickerPrice
+		errs   []error
+	)
+	for _, symbol := range symbols {
+		price, err := p.GetQuote(ctx, symbol, exchange)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", symbol, err))
+			continue
+		}
+		prices = append(prices, *price)
+	}
+	return prices, errors.Join(errs...)
+}
+
+// GetHistory reads daily closes from TIME_SERIES_DAILY (compact, roughly the last 100 trading days)
+func (p *AlphaVantageProvider) GetHistory(ctx context.Context, symbol string, from time.Time, to time.Time) ([]models.TickerPrice, error) {
+	raw, err := p.query(ctx, func(apiKey string) string {
+		return p.vantageConfig.GetDailySeriesUrl(symbol, apiKey)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	series, ok := raw["Time Series (Daily)"].(map[string]interface{})
+	if !ok {
+		if raw["Information"] != nil {
+			return nil, fmt.Errorf("❌ API error: %v", raw["Information"])
+		}
+		return nil, fmt.Errorf("⚠️ Missing or invalid Time Series: %v", raw)
+	}
+
+	var prices []models.TickerPrice
+	for day, values := range series {
+		parsedTimestamp, err := time.Parse("2006-01-02", day)
+		if err != nil || parsedTimestamp.Before(from) || parsedTimestamp.After(to) {
+			continue
+		}
+		bar, _ := values.(map[string]interface{})
+		closePrice, _ := bar["4. close"].(string)
+		volume, _ := bar["5. volume"].(string)
+		priceFloat, err := strconv.ParseFloat(closePrice, 64)
+		if err != nil {
+			continue
+		}
+		volumeInt, _ := strconv.ParseInt(volume, 10, 64)
+		prices = append(prices, models.TickerPrice{
+			Symbol:    symbol,
+			Price:     priceFloat,
+			Volume:    volumeInt,
+			Timestamp: parsedTimestamp,
+		})
+	}
+
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].Timestamp.Before(prices[j].Timestamp)
+	})
+	return prices, nil
+}
+
+// quoteTimestamp turns GLOBAL_QUOTE's "latest trading day", a date on the symbol's exchange,
+// into an observation time. A quote for a session still running is live as of now, any other
+// is that day's closing price. The result is never later than now.
+func quoteTimestamp(tradingDay string, exchange *calendar.Exchange, now time.Time) (time.Time, error) {
+	day, err := time.ParseInLocation("2006-01-02", tradingDay, exchange.Location())
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// a day the calendar has no session for still closed at the exchange's last close before it ended
+	closesAt := exchange.PreviousClose(day.AddDate(0, 0, 1))
+	if _, sessionClose, ok := exchange.Session(day); ok {
+		closesAt = sessionClose
+	}
+	if closesAt.IsZero() || closesAt.After(now) {
+		return now.UTC(), nil
+	}
+	return closesAt.UTC(), nil
+}
+
+func (p *AlphaVantageProvider) KeyHealth() []KeyHealth {
+	return p.keyPool.Health()
+}
+
+// query calls Alpha Vantage with the next available key, moving on to another key when one gets throttled
+func (p *AlphaVantageProvider) query(ctx context.Context, buildUrl func(apiKey string) string) (map[string]interface{}, error) {
+	var lastErr error
+	for attempt := 0; attempt < len(p.vantageConfig.ApiKeys()); attempt++ {
+		apiKey, err := p.keyPool.Acquire()
+		if err != nil {
+			if lastErr != nil {
+				return nil, fmt.Errorf("%w (last error: %v)", err, lastErr)
+			}
+			return nil, err
+		}
+
+		raw, err := p.queryWithKey(ctx, buildUrl(apiKey))
+		var throttled *throttleError
+		if errors.As(err, &throttled) {
+			log.Printf("⚠️ Alpha Vantage key %s throttled (daily=%t): %s", maskKey(apiKey), throttled.daily, throttled.message)
+			p.keyPool.ReportThrottled(apiKey, throttled.daily, throttled.message)
+			lastErr = err
+			continue
+		}
+		return raw, err
+	}
+	return nil, lastErr
+}
+
+type throttleError struct {
+	message string
+	daily   bool
+}
+
+func (e *throttleError) Error() string {
+	return fmt.Sprintf("rate limited or API error: %s", e.message)
+}
+
+func (e *throttleError) Unwrap() error {
+	return ErrRateLimited
+}
+
+// throttleFromMessage recognises Alpha Vantage's quota messages, which arrive either as a
+// "Note" or as an "Information" payload with a 200 status
+func throttleFromMessage(message string) *throttleError {
+	lower := strings.ToLower(message)
+	if !strings.Contains(lower, "rate limit") && !strings.Contains(lower, "call frequency") &&
+		!strings.Contains(lower, "per minute") && !strings.Contains(lower, "per day") && !strings.Contains(lower, "burst") {
+		return nil
+	}
+	daily := strings.Contains(lower, "per day") && !strings.Contains(lower, "per minute")
+	return &throttleError{message: message, daily: daily}
+}
+
+// queryWithKey performs the request and handles the error formats shared by every Alpha Vantage function
+func (p *AlphaVantageProvider) queryWithKey(ctx context.Context, externalApiUrl string) (map[string]interface{}, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, externalApiUrl, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		log.Println(err)
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	body, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		log.Println(err)
+		return nil, fmt.Errorf("❌ failed to read response: %w", err)
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		log.Printf("❌ Failed to unmarshal response: %v", err)
+		log.Printf("🔎 Raw response: %s", string(body))
+		return nil, fmt.Errorf("❌ failed to decode JSON: %w", err)
+	}
+
+	// Handle known error formats from Alpha Vantage
+	if note, ok := raw["Note"]; ok {
+		log.Printf("⚠️ Alpha Vantage Note: %v", note)
+		if throttled := throttleFromMessage(fmt.Sprint(note)); throttled != nil {
+			return nil, throttled
+		}
+		return nil, fmt.Errorf("rate limited or API error: %v", note)
+	}
+	if information, ok := raw["Information"]; ok {
+		if throttled := throttleFromMessage(fmt.Sprint(information)); throttled != nil {
+			return nil, throttled
+		}
+	}
+	if errMsg, ok := raw["Error Message"]; ok {
+		log.Printf("⚠️ Alpha Vantage Error: %v", errMsg)
+		return nil, fmt.Errorf("api error: %v", errMsg)
+	}
+
+	return raw, nil
+}
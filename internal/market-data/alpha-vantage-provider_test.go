@@ -0,0 +1,47 @@
+package market_data
+
+import (
+	"context"
+	"fmt"
+	"github.com/khorzhenwin/gold-digger/internal/calendar"
+	"github.com/khorzhenwin/gold-digger/internal/config"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAlphaVantageQuoteUsesTheGivenExchange(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"Global Quote": {"01. symbol": %q, "05. price": "123.4500", "06. volume": "1000", "07. latest trading day": "2025-10-16"}}`, r.URL.Query().Get("symbol"))
+	}))
+	defer server.Close()
+	provider := NewAlphaVantageProvider(&config.VantageConfig{ApiKey: "demo", BaseUrl: server.URL, DailyLimit: 25, MinuteLimit: 5})
+
+	tests := []struct {
+		name     string
+		symbol   string
+		exchange *calendar.Exchange
+		want     time.Time
+	}{
+		{name: "the NYSE close", symbol: "PLTR", exchange: calendar.NYSE, want: time.Date(2025, time.October, 16, 20, 0, 0, 0, time.UTC)},
+		{name: "a symbol without a suffix on the LSE", symbol: "VOD", exchange: calendar.LSE, want: time.Date(2025, time.October, 16, 15, 30, 0, 0, time.UTC)},
+		{name: "a symbol without a suffix on SGX", symbol: "D05", exchange: calendar.SGX, want: time.Date(2025, time.October, 16, 9, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price, err := provider.GetQuote(context.Background(), tt.symbol, tt.exchange)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !price.Timestamp.Equal(tt.want) || price.Price != 123.45 || price.Volume != 1000 {
+				t.Errorf("got %s at %s, want 123.45 at %s", tt.symbol, price.Timestamp, tt.want)
+			}
+		})
+	}
+
+	if _, err := provider.GetQuote(context.Background(), "PLTR", nil); err == nil {
+		t.Error("got a quote without an exchange, want an error")
+	}
+}
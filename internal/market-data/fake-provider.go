@@ -0,0 +1,155 @@
+package market_data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/khorzhenwin/gold-digger/internal/calendar"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"sort"
+	"sync"
+	"time"
+)
+
+// FakeProvider serves canned quotes from memory so the services built on QuoteProvider can be
+// tested without calling a real API
+type FakeProvider struct {
+	mu            sync.Mutex
+	quotes        map[string]models.TickerPrice
+	history       map[string][]models.TickerPrice
+	errs          map[string]error
+	calls         []string
+	delay         time.Duration
+	extendedHours bool
+}
+
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{
+		quotes:  make(map[string]models.TickerPrice),
+		history: make(map[string][]models.TickerPrice),
+		errs:    make(map[string]error),
+	}
+}
+
+// SetQuote makes price the latest quote for its symbol
+func (p *FakeProvider) SetQuote(price models.TickerPrice) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.quotes[price.Symbol] = price
+}
+
+// SetError makes every request for symbol fail with err
+func (p *FakeProvider) SetError(symbol string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.errs[symbol] = err
+}
+
+// AddHistory adds prices to the history of their symbols
+func (p *FakeProvider) AddHistory(prices ...models.TickerPrice) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, price := range prices {
+		p.history[price.Symbol] = append(p.history[price.Symbol], price)
+	}
+}
+
+// SetDelay makes every request take d, or until its context is done
+func (p *FakeProvider) SetDelay(d time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.delay = d
+}
+
+// SetExtendedHours sets whether the quotes are treated as including extended-hours trades
+func (p *FakeProvider) SetExtendedHours(extendedHours bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.extendedHours = extendedHours
+}
+
+// Calls returns the symbols requested so far, in order
+func (p *FakeProvider) Calls() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.calls...)
+}
+
+func (p *FakeProvider) Name() string {
+	return "fake"
+}
+
+// GetQuote returns the quote set for symbol as is, its timestamp does not depend on exchange
+func (p *FakeProvider) GetQuote(ctx context.Context, symbol string, exchange *calendar.Exchange) (*models.TickerPrice, error) {
+	if err := p.request(ctx, symbol); err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	price, ok := p.quotes[symbol]
+	if !ok {
+		return nil, fmt.Errorf("no quote for %s", symbol)
+	}
+	return &price, nil
+}
+
+func (p *FakeProvider) GetQuotes(ctx context.Context, symbols []string, exchange *calendar.Exchange) ([]models.TickerPrice, error) {
+	var (
+		prices []models.TickerPrice
+		errs   []error
+	)
+	for _, symbol := range symbols {
+		price, err := p.GetQuote(ctx, symbol, exchange)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", symbol, err))
+			continue
+		}
+		prices = append(prices, *price)
+	}
+	return prices, errors.Join(errs...)
+}
+
+func (p *FakeProvider) GetHistory(ctx context.Context, symbol string, from time.Time, to time.Time) ([]models.TickerPrice, error) {
+	if err := p.request(ctx, symbol); err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var prices []models.TickerPrice
+	for _, price := range p.history[symbol] {
+		if !price.Timestamp.Before(from) && !price.Timestamp.After(to) {
+			prices = append(prices, price)
+		}
+	}
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].Timestamp.Before(prices[j].Timestamp)
+	})
+	return prices, nil
+}
+
+func (p *FakeProvider) QuotesExtendedHours() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.extendedHours
+}
+
+// request records a call to symbol, waits out the delay and returns symbol's error, if any
+func (p *FakeProvider) request(ctx context.Context, symbol string) error {
+	p.mu.Lock()
+	p.calls = append(p.calls, symbol)
+	delay, err := p.delay, p.errs[symbol]
+	p.mu.Unlock()
+
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return err
+}
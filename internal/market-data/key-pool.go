@@ -0,0 +1,198 @@
+package market_data
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var (
+	ErrRateLimited       = errors.New("rate limited by provider")
+	ErrNoApiKeyAvailable = errors.New("no API key available")
+)
+
+// KeyHealth is a snapshot of one API key, with the key itself masked
+type KeyHealth struct {
+	Key          string     `json:"key"`
+	DailyUsed    int        `json:"daily_used"`
+	DailyLimit   int        `json:"daily_limit"`
+	MinuteUsed   int        `json:"minute_used"`
+	MinuteLimit  int        `json:"minute_limit"`
+	Available    bool       `json:"available"`
+	ParkedUntil  *time.Time `json:"parked_until,omitempty"`
+	LastUsedAt   *time.Time `json:"last_used_at,omitempty"`
+	LastError    string     `json:"last_error,omitempty"`
+	LastErrorAt  *time.Time `json:"last_error_at,omitempty"`
+	DailyResetAt time.Time  `json:"daily_reset_at"`
+}
+
+// KeyHealthReporter is implemented by providers that rotate through a KeyPool
+type KeyHealthReporter interface {
+	KeyHealth() []KeyHealth
+}
+
+// KeyPool hands out API keys in configured order, tracking per-key daily and per-minute usage.
+// Keys that hit a quota or get throttled are parked until their window resets.
+// Daily quotas reset at midnight UTC.
+type KeyPool struct {
+	mu          sync.Mutex
+	keys        []*keyState
+	dailyLimit  int
+	minuteLimit int
+	now         func() time.Time
+}
+
+type keyState struct {
+	key         string
+	day         time.Time
+	dailyUsed   int
+	minute      time.Time
+	minuteUsed  int
+	parkedUntil time.Time
+	lastUsedAt  time.Time
+	lastError   string
+	lastErrorAt time.Time
+}
+
+// NewKeyPool creates a pool over keys, a limit of 0 means unlimited
+func NewKeyPool(keys []string, dailyLimit int, minuteLimit int) *KeyPool {
+	pool := &KeyPool{dailyLimit: dailyLimit, minuteLimit: minuteLimit, now: time.Now}
+	for _, key := range keys {
+		if key != "" {
+			pool.keys = append(pool.keys, &keyState{key: key})
+		}
+	}
+	return pool
+}
+
+// Acquire reserves one request on the first key with quota left
+func (p *KeyPool) Acquire() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	var earliest time.Time
+	for _, k := range p.keys {
+		p.roll(k, now)
+		if readyAt := p.readyAt(k, now); !readyAt.IsZero() {
+			if earliest.IsZero() || readyAt.Before(earliest) {
+				earliest = readyAt
+			}
+			continue
+		}
+
+		k.dailyUsed++
+		k.minuteUsed++
+		k.lastUsedAt = now
+		return k.key, nil
+	}
+
+	if earliest.IsZero() {
+		return "", ErrNoApiKeyAvailable
+	}
+	return "", fmt.Errorf("%w until %s", ErrNoApiKeyAvailable, earliest.UTC().Format(time.RFC3339))
+}
+
+// ReportThrottled parks key until the next minute, or the next day when the daily quota was hit
+func (p *KeyPool) ReportThrottled(key string, daily bool, message string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	for _, k := range p.keys {
+		if k.key != key {
+			continue
+		}
+		p.roll(k, now)
+		k.lastError = message
+		k.lastErrorAt = now
+		if daily {
+			k.parkedUntil = nextDay(now)
+		} else {
+			k.parkedUntil = k.minute.Add(time.Minute)
+		}
+		return
+	}
+}
+
+func (p *KeyPool) Health() []KeyHealth {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	health := make([]KeyHealth, 0, len(p.keys))
+	for _, k := range p.keys {
+		p.roll(k, now)
+		h := KeyHealth{
+			Key:          maskKey(k.key),
+			DailyUsed:    k.dailyUsed,
+			DailyLimit:   p.dailyLimit,
+			MinuteUsed:   k.minuteUsed,
+			MinuteLimit:  p.minuteLimit,
+			Available:    p.readyAt(k, now).IsZero(),
+			LastError:    k.lastError,
+			DailyResetAt: nextDay(now),
+		}
+		if k.parkedUntil.After(now) {
+			h.ParkedUntil = timePtr(k.parkedUntil)
+		}
+		if !k.lastUsedAt.IsZero() {
+			h.LastUsedAt = timePtr(k.lastUsedAt)
+		}
+		if !k.lastErrorAt.IsZero() {
+			h.LastErrorAt = timePtr(k.lastErrorAt)
+		}
+		health = append(health, h)
+	}
+	return health
+}
+
+// roll resets counters whose day or minute window has passed
+func (p *KeyPool) roll(k *keyState, now time.Time) {
+	if day := now.UTC().Truncate(24 * time.Hour); !day.Equal(k.day) {
+		k.day = day
+		k.dailyUsed = 0
+	}
+	if minute := now.Truncate(time.Minute); !minute.Equal(k.minute) {
+		k.minute = minute
+		k.minuteUsed = 0
+	}
+}
+
+// readyAt returns when key can be used again, or zero if it can be used now
+func (p *KeyPool) readyAt(k *keyState, now time.Time) time.Time {
+	var readyAt time.Time
+	if k.parkedUntil.After(now) {
+		readyAt = k.parkedUntil
+	}
+	if p.dailyLimit > 0 && k.dailyUsed >= p.dailyLimit {
+		readyAt = later(readyAt, nextDay(now))
+	}
+	if p.minuteLimit > 0 && k.minuteUsed >= p.minuteLimit {
+		readyAt = later(readyAt, k.minute.Add(time.Minute))
+	}
+	return readyAt
+}
+
+func nextDay(now time.Time) time.Time {
+	return now.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+}
+
+func later(a time.Time, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+func maskKey(key string) string {
+	if len(key) <= 4 {
+		return "****"
+	}
+	return "****" + key[len(key)-4:]
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
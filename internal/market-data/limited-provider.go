@@ -0,0 +1,125 @@
+package market_data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/khorzhenwin/gold-digger/internal/calendar"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"sync"
+	"time"
+)
+
+// LimitedProvider routes every call through a token bucket and a bounded number of in-flight
+// requests. Calls queue until they get a slot and a token, or until the caller's deadline
+// passes. The queue timeout only bounds calls whose context has no deadline of its own.
+type LimitedProvider struct {
+	next         QuoteProvider
+	limiter      *RateLimiter
+	slots        chan struct{}
+	queueTimeout time.Duration
+}
+
+func NewLimitedProvider(next QuoteProvider, perMinute int, maxConcurrency int, queueTimeout time.Duration) *LimitedProvider {
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+
+	p := &LimitedProvider{
+		next:         next,
+		slots:        make(chan struct{}, maxConcurrency),
+		queueTimeout: queueTimeout,
+	}
+	if perMinute > 0 {
+		p.limiter = NewRateLimiter(perMinute, 1)
+	}
+	return p
+}
+
+func (p *LimitedProvider) Name() string {
+	return p.next.Name()
+}
+
+func (p *LimitedProvider) GetQuote(ctx context.Context, symbol string, exchange *calendar.Exchange) (*models.TickerPrice, error) {
+	release, err := p.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return p.next.GetQuote(ctx, symbol, exchange)
+}
+
+// GetQuotes fans out one limited GetQuote per symbol so a batch cannot bypass the limiter
+func (p *LimitedProvider) GetQuotes(ctx context.Context, symbols []string, exchange *calendar.Exchange) ([]models.TickerPrice, error) {
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		prices []models.TickerPrice
+		errs   []error
+	)
+	for _, symbol := range symbols {
+		wg.Add(1)
+		go func(symbol string) {
+			defer wg.Done()
+			price, err := p.GetQuote(ctx, symbol, exchange)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", symbol, err))
+				return
+			}
+			prices = append(prices, *price)
+		}(symbol)
+	}
+	wg.Wait()
+	return prices, errors.Join(errs...)
+}
+
+func (p *LimitedProvider) GetHistory(ctx context.Context, symbol string, from time.Time, to time.Time) ([]models.TickerPrice, error) {
+	release, err := p.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return p.next.GetHistory(ctx, symbol, from, to)
+}
+
+// KeyHealth passes through to the wrapped provider when it tracks API keys
+func (p *LimitedProvider) KeyHealth() []KeyHealth {
+	if reporter, ok := p.next.(KeyHealthReporter); ok {
+		return reporter.KeyHealth()
+	}
+	return []KeyHealth{}
+}
+
+// QuotesExtendedHours passes through to the wrapped provider
+func (p *LimitedProvider) QuotesExtendedHours() bool {
+	return QuotesExtendedHours(p.next)
+}
+
+// acquire waits for a concurrency slot and then a rate token. A caller with a deadline, like
+// the poller whose requests may queue until the next poll is due, waits as long as it allows.
+func (p *LimitedProvider) acquire(ctx context.Context) (func(), error) {
+	queueCtx := ctx
+	if _, ok := ctx.Deadline(); !ok && p.queueTimeout > 0 {
+		var cancel context.CancelFunc
+		queueCtx, cancel = context.WithTimeout(ctx, p.queueTimeout)
+		defer cancel()
+	}
+
+	select {
+	case p.slots <- struct{}{}:
+	case <-queueCtx.Done():
+		return nil, fmt.Errorf("waiting for a %s request slot: %w", p.next.Name(), queueCtx.Err())
+	}
+	release := func() { <-p.slots }
+
+	if p.limiter != nil {
+		if err := p.limiter.Wait(queueCtx); err != nil {
+			release()
+			return nil, fmt.Errorf("waiting for %s rate limit: %w", p.next.Name(), err)
+		}
+	}
+	return release, nil
+}
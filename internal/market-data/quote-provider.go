@@ -0,0 +1,62 @@
+package market_data
+
+import (
+	"context"
+	"fmt"
+	"github.com/khorzhenwin/gold-digger/internal/calendar"
+	"github.com/khorzhenwin/gold-digger/internal/config"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"time"
+)
+
+const ProviderAlphaVantage = "alphavantage"
+
+// QuoteProvider is the source of market data used by the poller and the price APIs.
+type QuoteProvider interface {
+	// Name identifies the provider in logs and configuration
+	Name() string
+	// GetQuote returns the latest price for a single symbol trading on exchange, whose
+	// calendar dates the quote when the provider only reports a trading day
+	GetQuote(ctx context.Context, symbol string, exchange *calendar.Exchange) (*models.TickerPrice, error)
+	// GetQuotes returns the latest price for each symbol on exchange it could resolve.
+	// Symbols that failed are reported through the returned error.
+	GetQuotes(ctx context.Context, symbols []string, exchange *calendar.Exchange) ([]models.TickerPrice, error)
+	// GetHistory returns prices for a symbol between from and to, oldest first
+	GetHistory(ctx context.Context, symbol string, from time.Time, to time.Time) ([]models.TickerPrice, error)
+}
+
+// ExtendedHoursQuoter is implemented by providers whose quotes include pre-market and
+// after-hours trades. Other providers keep serving the regular session's last price after the close.
+type ExtendedHoursQuoter interface {
+	QuotesExtendedHours() bool
+}
+
+// QuotesExtendedHours reports whether provider's quotes include extended-hours trades
+func QuotesExtendedHours(provider QuoteProvider) bool {
+	quoter, ok := provider.(ExtendedHoursQuoter)
+	return ok && quoter.QuotesExtendedHours()
+}
+
+// NewProvider builds the configured provider behind a rate limiter and concurrency cap
+func NewProvider(marketDataConfig *config.MarketDataConfig, vantageConfig *config.VantageConfig) (QuoteProvider, error) {
+	var (
+		provider  QuoteProvider
+		perMinute = marketDataConfig.RequestsPerMinute
+	)
+
+	switch marketDataConfig.Provider {
+	case ProviderAlphaVantage:
+		if vantageConfig == nil {
+			return nil, fmt.Errorf("provider %q requires Vantage config", ProviderAlphaVantage)
+		}
+		provider = NewAlphaVantageProvider(vantageConfig)
+		// every key brings its own per-minute quota
+		if perMinute == 0 {
+			perMinute = vantageConfig.MinuteLimit * len(vantageConfig.ApiKeys())
+		}
+	default:
+		return nil, fmt.Errorf("unknown market data provider %q", marketDataConfig.Provider)
+	}
+
+	return NewLimitedProvider(provider, perMinute, marketDataConfig.MaxConcurrency, marketDataConfig.QueueTimeout), nil
+}
@@ -0,0 +1,150 @@
+package market_data
+
+import (
+	"context"
+	"errors"
+	"github.com/khorzhenwin/gold-digger/internal/calendar"
+	"github.com/khorzhenwin/gold-digger/internal/config"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewProvider(t *testing.T) {
+	tests := []struct {
+		name           string
+		marketData     config.MarketDataConfig
+		vantage        *config.VantageConfig
+		wantName       string
+		wantErrContain string
+	}{
+		{name: "alpha vantage", marketData: config.MarketDataConfig{Provider: ProviderAlphaVantage}, vantage: &config.VantageConfig{}, wantName: ProviderAlphaVantage},
+		{name: "alpha vantage without its config", marketData: config.MarketDataConfig{Provider: ProviderAlphaVantage}, wantErrContain: "requires Vantage config"},
+		{name: "unknown provider", marketData: config.MarketDataConfig{Provider: "bloomberg"}, vantage: &config.VantageConfig{}, wantErrContain: "unknown market data provider"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := NewProvider(&tt.marketData, tt.vantage)
+			if tt.wantErrContain != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrContain) {
+					t.Fatalf("got error %v, want one containing %q", err, tt.wantErrContain)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := provider.(*LimitedProvider); !ok {
+				t.Errorf("got %T, want the provider behind a LimitedProvider", provider)
+			}
+			if provider.Name() != tt.wantName {
+				t.Errorf("got name %q, want %q", provider.Name(), tt.wantName)
+			}
+		})
+	}
+}
+
+func TestLimitedProviderGetQuotes(t *testing.T) {
+	now := time.Date(2026, time.October, 16, 15, 0, 0, 0, time.UTC)
+	fake := NewFakeProvider()
+	fake.SetQuote(models.TickerPrice{Symbol: "PLTR", Price: 180.5, Timestamp: now})
+	fake.SetQuote(models.TickerPrice{Symbol: "TEM", Price: 72.1, Timestamp: now})
+	fake.SetError("SOUN", errors.New("rate limited"))
+
+	provider := NewLimitedProvider(fake, 0, 2, time.Second)
+	prices, err := provider.GetQuotes(context.Background(), []string{"PLTR", "TEM", "SOUN"}, calendar.NYSE)
+
+	if err == nil || !strings.Contains(err.Error(), "SOUN: rate limited") {
+		t.Errorf("got error %v, want SOUN's failure reported", err)
+	}
+	var symbols []string
+	for _, price := range prices {
+		symbols = append(symbols, price.Symbol)
+	}
+	sort.Strings(symbols)
+	if strings.Join(symbols, ",") != "PLTR,TEM" {
+		t.Errorf("got prices for %v, want PLTR and TEM", symbols)
+	}
+	if calls := fake.Calls(); len(calls) != 3 {
+		t.Errorf("got %d calls to the provider, want one per symbol", len(calls))
+	}
+}
+
+func TestLimitedProviderQueueDeadline(t *testing.T) {
+	fake := NewFakeProvider()
+	fake.SetQuote(models.TickerPrice{Symbol: "PLTR", Price: 180.5})
+	fake.SetDelay(200 * time.Millisecond)
+	provider := NewLimitedProvider(fake, 0, 1, time.Minute)
+
+	busy := make(chan error)
+	go func() {
+		_, err := provider.GetQuote(context.Background(), "PLTR", calendar.NYSE)
+		busy <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	// the only slot is taken, so a caller's own deadline ends the wait
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := provider.GetQuote(ctx, "PLTR", calendar.NYSE); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want the queue deadline exceeded", err)
+	}
+	if err := <-busy; err != nil {
+		t.Errorf("in-flight request failed: %v", err)
+	}
+	if calls := fake.Calls(); len(calls) != 1 {
+		t.Errorf("got %d calls to the provider, want the queued one never sent", len(calls))
+	}
+}
+
+func TestLimitedProviderRateLimit(t *testing.T) {
+	fake := NewFakeProvider()
+	fake.SetQuote(models.TickerPrice{Symbol: "PLTR", Price: 180.5})
+	// one request a minute, the bucket starts with a single token
+	provider := NewLimitedProvider(fake, 1, 4, 50*time.Millisecond)
+
+	if _, err := provider.GetQuote(context.Background(), "PLTR", calendar.NYSE); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := provider.GetQuote(context.Background(), "PLTR", calendar.NYSE); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want the second request held back by the rate limit", err)
+	}
+}
+
+func TestQuotesExtendedHours(t *testing.T) {
+	fake := NewFakeProvider()
+	limited := NewLimitedProvider(fake, 0, 1, time.Second)
+	if QuotesExtendedHours(limited) {
+		t.Error("got extended hours from a provider without them")
+	}
+
+	fake.SetExtendedHours(true)
+	if !QuotesExtendedHours(limited) {
+		t.Error("LimitedProvider did not pass extended hours through")
+	}
+	if QuotesExtendedHours(NewAlphaVantageProvider(&config.VantageConfig{})) {
+		t.Error("got extended hours from Alpha Vantage")
+	}
+}
+
+func TestFakeProviderHistory(t *testing.T) {
+	start := time.Date(2026, time.October, 12, 0, 0, 0, 0, time.UTC)
+	fake := NewFakeProvider()
+	fake.AddHistory(
+		models.TickerPrice{Symbol: "PLTR", Price: 3, Timestamp: start.AddDate(0, 0, 2)},
+		models.TickerPrice{Symbol: "PLTR", Price: 1, Timestamp: start},
+		models.TickerPrice{Symbol: "PLTR", Price: 2, Timestamp: start.AddDate(0, 0, 1)},
+		models.TickerPrice{Symbol: "TEM", Price: 9, Timestamp: start},
+	)
+
+	prices, err := fake.GetHistory(context.Background(), "PLTR", start, start.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(prices) != 2 || prices[0].Price != 1 || prices[1].Price != 2 {
+		t.Errorf("got %+v, want PLTR's first two days oldest first", prices)
+	}
+}
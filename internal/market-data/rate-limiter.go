@@ -0,0 +1,57 @@
+package market_data
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket refilled at a fixed rate per minute
+type RateLimiter struct {
+	mu           sync.Mutex
+	tokens       float64
+	burst        float64
+	refillPerSec float64
+	last         time.Time
+}
+
+func NewRateLimiter(perMinute int, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		tokens:       float64(burst),
+		burst:        float64(burst),
+		refillPerSec: float64(perMinute) / 60,
+		last:         time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or ctx is done
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		l.tokens += now.Sub(l.last).Seconds() * l.refillPerSec
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.last = now
+
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+		wait := time.Duration((1 - l.tokens) / l.refillPerSec * float64(time.Second))
+		l.mu.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -1,10 +1,56 @@
 package models
 
+import "context"
+
 type Notifier interface {
-	Send(message string) error
+	Send(ctx context.Context, message string) error
+}
+
+// NotifierConfig holds every notification channel, messages fan out to each enabled one
+type NotifierConfig struct {
+	Telegram TelegramNotifier
+	Slack    SlackNotifier
+	Discord  DiscordNotifier
+	Email    EmailNotifier
+	Webhook  WebhookNotifier
+	// TemplateDir holds *.tmpl files overriding the built-in message templates
+	TemplateDir string
 }
 
 type TelegramNotifier struct {
+	Enabled  bool
 	BotToken string
 	ChatID   string
+	// ParseMode is MarkdownV2, HTML or empty for plain text
+	ParseMode string
+}
+
+// SlackNotifier posts to a Slack incoming webhook
+type SlackNotifier struct {
+	Enabled    bool
+	WebhookURL string
+}
+
+type DiscordNotifier struct {
+	Enabled    bool
+	WebhookURL string
+}
+
+// EmailNotifier sends through SMTP, with STARTTLS when offered or implicit TLS on port 465
+type EmailNotifier struct {
+	Enabled  bool
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	To       []string
+}
+
+// WebhookNotifier posts a JSON payload to any HTTP endpoint. With a Secret, the body is signed
+// with HMAC-SHA256 in the X-Gold-Digger-Signature header.
+type WebhookNotifier struct {
+	Enabled bool
+	URL     string
+	Secret  string
 }
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+const (
+	OutboxPending = "pending"
+	OutboxSent    = "sent"
+	// OutboxDead holds deliveries that ran out of attempts, they are only retried by a resend
+	OutboxDead = "dead"
+	// OutboxDigested messages were folded into the digest message DigestID and are not sent themselves
+	OutboxDigested = "digested"
+)
+
+// OutboxMessage is one message queued for one notification channel
+type OutboxMessage struct {
+	ID            uint       `gorm:"primaryKey" json:"id"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	Channel       string     `gorm:"index" json:"channel"`
+	Message       string     `json:"message"`
+	Status        string     `gorm:"index" json:"status"`
+	Attempts      int        `json:"attempts"`
+	NextAttemptAt time.Time  `gorm:"index" json:"next_attempt_at"`
+	LastError     string     `json:"last_error,omitempty"`
+	SentAt        *time.Time `json:"sent_at,omitempty"`
+	DigestID      *uint      `json:"digest_id,omitempty"`
+	// Folded is how many messages a digest holds, 0 for any other message
+	Folded int `json:"folded,omitempty"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+const (
+	// PriceAlertAbove fires when the price reaches Level or higher
+	PriceAlertAbove = "above"
+	// PriceAlertBelow fires when the price reaches Level or lower
+	PriceAlertBelow = "below"
+	// PriceAlertPctUp fires when the price rose Level percent from ReferencePrice
+	PriceAlertPctUp = "pct_up"
+	// PriceAlertPctDown fires when the price fell Level percent from ReferencePrice
+	PriceAlertPctDown = "pct_down"
+)
+
+// PriceAlert is an absolute or percent-from-reference level on a watchlist item.
+// One-shot alerts disarm for good once fired, repeating alerts re-arm when the price crosses back.
+type PriceAlert struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	TickerID  uint      `gorm:"index" json:"ticker_id"`
+	Symbol    string    `gorm:"index" json:"symbol"`
+	Condition string    `json:"condition"` // above, below, pct_up or pct_down
+	Level     float64   `json:"level"`     // a price, or a percent for pct_up and pct_down
+	// ReferencePrice anchors percent alerts, it is taken from the first price seen when left at 0
+	ReferencePrice float64    `json:"reference_price,omitempty"`
+	Repeat         bool       `json:"repeat"`
+	Armed          bool       `json:"armed"`
+	TriggerCount   int        `json:"trigger_count"`
+	TriggeredAt    *time.Time `json:"triggered_at,omitempty"`
+	Note           string     `json:"note,omitempty"`
+}
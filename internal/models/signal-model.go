@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// Signal is a strategy signal as it fired, along with whether its notification went out.
+// Sent means the notifier accepted it, per-channel delivery is tracked by the notification outbox.
+type Signal struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	Symbol      string     `gorm:"index" json:"symbol"`
+	Strategy    string     `gorm:"index" json:"strategy"`
+	Direction   string     `json:"direction"` // BUY or DIP
+	Strength    float64    `json:"strength"`
+	Reason      string     `json:"reason"`
+	Price       float64    `json:"price"`
+	ChangePct   float64    `json:"change_pct"`
+	WindowStart time.Time  `json:"window_start"`
+	WindowEnd   time.Time  `json:"window_end"`
+	Sent        bool       `json:"sent"`
+	SendError   string     `json:"send_error,omitempty"`
+	SentAt      *time.Time `json:"sent_at,omitempty"`
+	CreatedAt   time.Time  `gorm:"index" json:"created_at"`
+}
@@ -0,0 +1,10 @@
+package models
+
+import "time"
+
+// SignalState persists the signal worker's cooldown so it survives restarts
+type SignalState struct {
+	Symbol       string `gorm:"primaryKey"`
+	LastSignalAt time.Time
+	UpdatedAt    time.Time
+}
@@ -6,5 +6,31 @@ type TickerPrice struct {
 	ID        uint   `gorm:"primaryKey"`
 	Symbol    string `gorm:"index"`
 	Price     float64
+	Volume    int64     // cumulative volume of the trading day, as reported by the provider
 	Timestamp time.Time `gorm:"index"`
+	// Session is the exchange session the price's timestamp falls in: regular, pre, post or
+	// closed. Pre and post are only recorded when the provider quotes extended hours. Prices
+	// stored before sessions were recorded have none and count as regular.
+	Session string `gorm:"size:10"`
+}
+
+// PriceCandle is an OHLC aggregate of stored prices over one time bucket
+type PriceCandle struct {
+	Bucket time.Time `json:"bucket"`
+	Open   float64   `json:"open"`
+	High   float64   `json:"high"`
+	Low    float64   `json:"low"`
+	Close  float64   `json:"close"`
+	Volume int64     `json:"volume"`
+	Count  int64     `json:"count"`
+}
+
+// PriceMove summarises a symbol's stored prices over a period, e.g. one trading day
+type PriceMove struct {
+	Symbol string  `json:"symbol"`
+	Open   float64 `json:"open"`
+	High   float64 `json:"high"`
+	Low    float64 `json:"low"`
+	Close  float64 `json:"close"`
+	Count  int64   `json:"count"`
 }
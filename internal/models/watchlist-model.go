@@ -4,10 +4,46 @@ import (
 	"time"
 )
 
+// Session modes decide when a watchlist item is polled
+const (
+	// SessionModeRegular polls during the exchange's regular session only
+	SessionModeRegular = "regular"
+	// SessionModeExtended also polls pre-market and after hours, 04:00 to 20:00 ET on US exchanges
+	SessionModeExtended = "extended"
+	// SessionModeAlways polls around the clock, including weekends and holidays
+	SessionModeAlways = "always"
+)
+
+// DefaultAlertWindowSize is the number of prices the signal worker keeps per symbol when the
+// alert config leaves WindowSize at zero
+const DefaultAlertWindowSize = 10
+
 type Ticker struct {
 	ID        uint      `json:"id"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
-	Symbol    string    `json:"symbol"` // e.g., AAPL
-	Notes     string    `json:"notes,omitempty"`
+	Symbol    string    `gorm:"size:32" json:"symbol"` // e.g., AAPL, D05.SI or BTC/USD
+	// Exchange is the market identifier code whose calendar schedules polling, e.g. XNYS, XSES
+	// or CRYPTO. Left empty it is inferred from the symbol's suffix.
+	Exchange string `gorm:"size:10;default:XNYS" json:"exchange"`
+	// SessionMode is regular, extended or always, left empty it is regular
+	SessionMode string      `gorm:"size:10;default:regular" json:"session_mode"`
+	Notes       string      `json:"notes,omitempty"`
+	Alert       AlertConfig `gorm:"embedded;embeddedPrefix:alert_" json:"alert"`
+}
+
+// AlertConfig tunes the signal worker for one watchlist item. Zero values fall back to the
+// worker's defaults: a 2% move over 4 steps, a 10 price window and a one hour cooldown.
+type AlertConfig struct {
+	MinChange       float64  `json:"min_change,omitempty"` // fraction, 0.05 is 5%
+	MinSteps        int      `json:"min_steps,omitempty"`
+	WindowSize      int      `json:"window_size,omitempty"`
+	CooldownMinutes int      `json:"cooldown_minutes,omitempty"`
+	Strategies      []string `gorm:"serializer:json" json:"strategies,omitempty"` // empty enables the default strategies
+	// ExtendedHoursStrategies also evaluate ticks polled outside the regular session, the other
+	// strategies only see ticks from the regular session
+	ExtendedHoursStrategies []string `gorm:"serializer:json" json:"extended_hours_strategies,omitempty"`
+	Muted                   bool     `json:"muted"`
+	// MutedUntil silences signals until it passes, e.g. after /mute TEM 2h in Telegram
+	MutedUntil *time.Time `json:"muted_until,omitempty"`
 }
@@ -0,0 +1,40 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"net/http"
+)
+
+// discordMaxContent is the longest message a Discord webhook accepts
+const discordMaxContent = 2000
+
+type DiscordNotifier struct {
+	config models.DiscordNotifier
+	client *http.Client
+}
+
+func NewDiscordNotifier(config models.DiscordNotifier, client *http.Client) *DiscordNotifier {
+	return &DiscordNotifier{config: config, client: client}
+}
+
+func (n *DiscordNotifier) Name() string {
+	return "discord"
+}
+
+func (n *DiscordNotifier) Format() string {
+	return FormatDiscord
+}
+
+func (n *DiscordNotifier) Send(ctx context.Context, message string) error {
+	content := []rune(message)
+	if len(content) > discordMaxContent {
+		content = append(content[:discordMaxContent-1], '…')
+	}
+
+	if err := postJSON(ctx, n.client, n.config.WebhookURL, map[string]string{"content": string(content)}, nil); err != nil {
+		return fmt.Errorf("failed to send discord message: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,325 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"html"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Message kinds, each has a <kind>.tmpl template and optional <kind>.<channel>.tmpl overrides
+const (
+	KindBuy        = "buy"
+	KindDip        = "dip"
+	KindSignal     = "signal"
+	KindPriceAlert = "price_alert"
+	KindSummary    = "summary"
+)
+
+var Kinds = []string{KindBuy, KindDip, KindSignal, KindPriceAlert, KindSummary}
+
+// Formats a channel renders messages in, anything outside the bold, italic and code
+// template funcs is escaped for the channel's format
+const (
+	FormatPlain      = ""
+	FormatMarkdownV2 = "MarkdownV2"
+	FormatHTML       = "HTML"
+	FormatSlack      = "slack"
+	FormatDiscord    = "discord"
+)
+
+var (
+	ErrUnknownTemplate = errors.New("no template for that kind")
+	ErrTemplate        = errors.New("failed to render message template")
+)
+
+//go:embed templates/*.tmpl
+var defaultTemplates embed.FS
+
+// MessageData is what a template renders. Signals fill the price, window and strategy
+// fields, price alerts fill Condition, Level and ReferencePrice and summaries Date and Movers.
+type MessageData struct {
+	Kind        string    `json:"kind"`
+	Symbol      string    `json:"symbol"`
+	Notes       string    `json:"notes"`
+	Price       float64   `json:"price"`
+	ChangePct   float64   `json:"change_pct"`
+	Strategy    string    `json:"strategy"`
+	Side        string    `json:"side"`
+	Strength    float64   `json:"strength"`
+	Reason      string    `json:"reason"`
+	Window      []float64 `json:"window"` // prices evaluated, oldest first
+	WindowStart time.Time `json:"window_start"`
+	WindowEnd   time.Time `json:"window_end"`
+
+	Condition      string  `json:"condition"`
+	Level          float64 `json:"level"`
+	ReferencePrice float64 `json:"reference_price"`
+
+	Date   time.Time `json:"date"`
+	Movers []Mover   `json:"movers"`
+}
+
+// Mover is one symbol's move over the day in an end-of-day summary
+type Mover struct {
+	Symbol    string  `json:"symbol"`
+	Notes     string  `json:"notes"`
+	Open      float64 `json:"open"`
+	High      float64 `json:"high"`
+	Low       float64 `json:"low"`
+	Close     float64 `json:"close"`
+	ChangePct float64 `json:"change_pct"`
+}
+
+var sparkTicks = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline draws Window as block characters scaled between its lowest and highest price
+func (d MessageData) Sparkline() string {
+	if len(d.Window) == 0 {
+		return ""
+	}
+	low, high := d.Window[0], d.Window[0]
+	for _, price := range d.Window {
+		low = min(low, price)
+		high = max(high, price)
+	}
+
+	var b strings.Builder
+	for _, price := range d.Window {
+		tick := len(sparkTicks) / 2
+		if high > low {
+			tick = int((price - low) / (high - low) * float64(len(sparkTicks)-1))
+		}
+		b.WriteRune(sparkTicks[tick])
+	}
+	return b.String()
+}
+
+// Templates holds the message templates, keyed by kind or kind.channel
+type Templates struct {
+	templates map[string]*template.Template
+}
+
+// NewTemplates loads the built-in templates and then any *.tmpl in dir, which replace the
+// built-in template of the same name. An empty dir only loads the built-in ones.
+func NewTemplates(dir string) (*Templates, error) {
+	t := &Templates{templates: make(map[string]*template.Template)}
+	if err := t.load(defaultTemplates, "templates"); err != nil {
+		return nil, err
+	}
+	if dir != "" {
+		if err := t.load(os.DirFS(dir), "."); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+func (t *Templates) load(fsys fs.FS, root string) error {
+	paths, err := fs.Glob(fsys, path.Join(root, "*.tmpl"))
+	if err != nil {
+		return err
+	}
+	for _, file := range paths {
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return err
+		}
+		name := strings.TrimSuffix(path.Base(file), ".tmpl")
+		parsed, err := parseTemplate(name, string(content))
+		if err != nil {
+			return err
+		}
+		t.templates[name] = parsed
+	}
+	return nil
+}
+
+// Names lists every loaded template, kind or kind.channel
+func (t *Templates) Names() []string {
+	names := make([]string, 0, len(t.templates))
+	for name := range t.templates {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Render picks the kind.channel template, falling back to kind, and formats it for format
+func (t *Templates) Render(kind string, channel string, format string, data MessageData) (string, error) {
+	tmpl, ok := t.templates[kind+"."+channel]
+	if !ok {
+		tmpl, ok = t.templates[kind]
+	}
+	if !ok {
+		return "", fmt.Errorf("%w %q", ErrUnknownTemplate, kind)
+	}
+	return execute(tmpl, format, data)
+}
+
+// RenderText parses text as a one-off template and formats it for format, used to preview edits
+func RenderText(text string, format string, data MessageData) (string, error) {
+	tmpl, err := parseTemplate("preview", text)
+	if err != nil {
+		return "", err
+	}
+	return execute(tmpl, format, data)
+}
+
+// SampleData is what previews render when no data is given
+func SampleData(kind string) MessageData {
+	end := time.Date(2025, 6, 2, 15, 30, 0, 0, time.UTC)
+	data := MessageData{
+		Kind:        kind,
+		Symbol:      "AAPL",
+		Notes:       "core holding",
+		Price:       203.27,
+		ChangePct:   2.41,
+		Strategy:    "momentum",
+		Side:        "BUY",
+		Strength:    0.8,
+		Reason:      "up 2.41% over the last 10 prices, 7 rising steps",
+		Window:      []float64{198.47, 198.9, 199.35, 199.1, 200.02, 200.8, 201.44, 201.2, 202.61, 203.27},
+		WindowStart: end.Add(-45 * time.Minute),
+		WindowEnd:   end,
+	}
+	switch kind {
+	case KindDip:
+		data.Side = "DIP"
+		data.ChangePct = -2.41
+		data.Reason = "down 2.41% over the last 10 prices, 7 falling steps"
+		for i, j := 0, len(data.Window)-1; i < j; i, j = i+1, j-1 {
+			data.Window[i], data.Window[j] = data.Window[j], data.Window[i]
+		}
+		data.Price = data.Window[len(data.Window)-1]
+	case KindPriceAlert:
+		data.Condition = "above"
+		data.Level = 200
+		data.Notes = "take profit"
+	case KindSummary:
+		data.Date = end
+		data.Movers = []Mover{
+			{Symbol: "PLTR", Open: 131.2, High: 136.9, Low: 130.75, Close: 136.1, ChangePct: 3.73},
+			{Symbol: "AAPL", Notes: "core holding", Open: 201.7, High: 203.9, Low: 200.95, Close: 203.27, ChangePct: 0.78},
+			{Symbol: "SOUN", Open: 11.02, High: 11.1, Low: 10.41, Close: 10.52, ChangePct: -4.54},
+		}
+	}
+	return data
+}
+
+// markers wrap the output of the formatting funcs so execute can escape the text around them.
+// They are Unicode private use characters, which never appear in market data.
+const (
+	markStart = '\uE000'
+	markEnd   = '\uE001'
+)
+
+func mark(style byte) func(any) string {
+	return func(value any) string {
+		return string(markStart) + string(style) + fmt.Sprint(value) + string(markEnd)
+	}
+}
+
+func parseTemplate(name string, text string) (*template.Template, error) {
+	return template.New(name).Funcs(template.FuncMap{
+		"bold":   mark('b'),
+		"italic": mark('i'),
+		"code":   mark('c'),
+		"upper":  strings.ToUpper,
+		"lower":  strings.ToLower,
+		"mul":    func(a, b float64) float64 { return a * b },
+	}).Parse(text)
+}
+
+func execute(tmpl *template.Template, format string, data MessageData) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return applyFormat(strings.TrimSpace(buf.String()), format), nil
+}
+
+// applyFormat escapes rendered for format and turns the marked spans into its markup.
+// Marked spans do not nest.
+func applyFormat(rendered string, format string) string {
+	var b strings.Builder
+	for {
+		start := strings.IndexRune(rendered, markStart)
+		if start < 0 {
+			b.WriteString(Escape(format, rendered))
+			return b.String()
+		}
+		b.WriteString(Escape(format, rendered[:start]))
+		rest := rendered[start+len(string(markStart)):]
+
+		end := strings.IndexRune(rest, markEnd)
+		if end < 1 {
+			// unbalanced, keep the remainder as plain text
+			b.WriteString(Escape(format, strings.NewReplacer(string(markStart), "", string(markEnd), "").Replace(rest)))
+			return b.String()
+		}
+		b.WriteString(style(format, rest[0], rest[1:end]))
+		rendered = rest[end+len(string(markEnd)):]
+	}
+}
+
+func style(format string, kind byte, text string) string {
+	switch format {
+	case FormatMarkdownV2:
+		switch kind {
+		case 'b':
+			return "*" + Escape(format, text) + "*"
+		case 'i':
+			return "_" + Escape(format, text) + "_"
+		case 'c':
+			// inside code only the backtick and backslash are special
+			return "`" + strings.NewReplacer(`\`, `\\`, "`", "\\`").Replace(text) + "`"
+		}
+	case FormatHTML:
+		tags := map[byte]string{'b': "b", 'i': "i", 'c': "code"}
+		return "<" + tags[kind] + ">" + html.EscapeString(text) + "</" + tags[kind] + ">"
+	case FormatSlack:
+		wraps := map[byte]string{'b': "*", 'i': "_", 'c': "`"}
+		return wraps[kind] + Escape(format, text) + wraps[kind]
+	case FormatDiscord:
+		if kind == 'c' {
+			// discord has no escapes inside code, so backticks are swapped for quotes
+			return "`" + strings.ReplaceAll(text, "`", "'") + "`"
+		}
+		wraps := map[byte]string{'b': "**", 'i': "_"}
+		return wraps[kind] + Escape(format, text) + wraps[kind]
+	}
+	return text
+}
+
+var (
+	markdownV2Escaper = strings.NewReplacer(
+		`\`, `\\`, "_", `\_`, "*", `\*`, "[", `\[`, "]", `\]`, "(", `\(`, ")", `\)`, "~", `\~`,
+		"`", "\\`", ">", `\>`, "#", `\#`, "+", `\+`, "-", `\-`, "=", `\=`, "|", `\|`,
+		"{", `\{`, "}", `\}`, ".", `\.`, "!", `\!`,
+	)
+	slackEscaper   = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	discordEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "~", `\~`, "`", "\\`", "|", `\|`, ">", `\>`)
+)
+
+// Escape makes text safe to send as-is in format
+func Escape(format string, text string) string {
+	switch format {
+	case FormatMarkdownV2:
+		return markdownV2Escaper.Replace(text)
+	case FormatHTML:
+		return html.EscapeString(text)
+	case FormatSlack:
+		return slackEscaper.Replace(text)
+	case FormatDiscord:
+		return discordEscaper.Replace(text)
+	default:
+		return text
+	}
+}
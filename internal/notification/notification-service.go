@@ -1,39 +1,142 @@
 package notification
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
 	"github.com/khorzhenwin/gold-digger/internal/models"
+	"log"
 	"net/http"
+	"sync"
+	"time"
 )
 
+// Channel is one destination a message can be delivered to. Send takes text already
+// escaped for the channel's Format.
+type Channel interface {
+	models.Notifier
+	Name() string
+	Format() string
+}
+
+// Service fans every message out to all registered channels concurrently.
+// A failing or panicking channel never stops delivery to the others.
 type Service struct {
-	notificationConfig models.TelegramNotifier
+	mu       sync.RWMutex
+	channels []Channel
+}
+
+// NewService registers every enabled channel of notifierConfig
+func NewService(notifierConfig *models.NotifierConfig) *Service {
+	client := &http.Client{Timeout: 10 * time.Second}
+	s := &Service{}
+
+	if notifierConfig.Telegram.Enabled {
+		s.Register(NewTelegramNotifier(notifierConfig.Telegram, client))
+	}
+	if notifierConfig.Slack.Enabled {
+		s.Register(NewSlackNotifier(notifierConfig.Slack, client))
+	}
+	if notifierConfig.Discord.Enabled {
+		s.Register(NewDiscordNotifier(notifierConfig.Discord, client))
+	}
+	if notifierConfig.Email.Enabled {
+		s.Register(NewEmailNotifier(notifierConfig.Email))
+	}
+	if notifierConfig.Webhook.Enabled {
+		s.Register(NewWebhookNotifier(notifierConfig.Webhook, client))
+	}
+
+	return s
+}
+
+func (s *Service) Register(channel Channel) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.channels = append(s.channels, channel)
+}
+
+// Channels lists the names of the registered channels
+func (s *Service) Channels() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	names := make([]string, 0, len(s.channels))
+	for _, channel := range s.channels {
+		names = append(names, channel.Name())
+	}
+	return names
 }
 
-func NewService(notificationConfig *models.TelegramNotifier) *Service {
-	return &Service{notificationConfig: *notificationConfig}
+// Format is the format messages for the named channel are rendered in
+func (s *Service) Format(name string) string {
+	if channel := s.channel(name); channel != nil {
+		return channel.Format()
+	}
+	return FormatPlain
 }
 
-func (s Service) Send(message string) error {
-	url := fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage", s.notificationConfig.BotToken)
+// Send delivers plain text message to every channel, escaped for each one's format. It only fails when no channel delivered it,
+// so a caller retrying on error does not repeat the message on channels that succeeded.
+func (s *Service) Send(ctx context.Context, message string) error {
+	s.mu.RLock()
+	channels := append([]Channel(nil), s.channels...)
+	s.mu.RUnlock()
+
+	if len(channels) == 0 {
+		return errors.New("no notification channel registered")
+	}
+
+	errs := make([]error, len(channels))
+	var wg sync.WaitGroup
+	for i, channel := range channels {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = sendIsolated(ctx, channel, Escape(channel.Format(), message))
+		}()
+	}
+	wg.Wait()
 
-	payload := map[string]string{
-		"chat_id": s.notificationConfig.ChatID,
-		"text":    message,
+	delivered := 0
+	for i, err := range errs {
+		if err != nil {
+			log.Printf("⚠️ Failed to notify via %s: %v", channels[i].Name(), err)
+			continue
+		}
+		delivered++
+	}
+	if delivered == 0 {
+		return fmt.Errorf("every notification channel failed: %w", errors.Join(errs...))
 	}
-	body, _ := json.Marshal(payload)
+	return nil
+}
 
-	resp, err := http.Post(url, "application/json", bytes.NewBuffer(body))
-	if err != nil {
-		fmt.Printf("error: %v", err)
-		return fmt.Errorf("failed to send telegram message: %w", err)
+// SendTo delivers message, already formatted for the channel, to a single channel by name
+func (s *Service) SendTo(ctx context.Context, name string, message string) error {
+	target := s.channel(name)
+	if target == nil {
+		return fmt.Errorf("notification channel %q is not registered", name)
 	}
-	defer resp.Body.Close()
+	return sendIsolated(ctx, target, message)
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("telegram API responded with status: %d", resp.StatusCode)
+func (s *Service) channel(name string) Channel {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, channel := range s.channels {
+		if channel.Name() == name {
+			return channel
+		}
 	}
 	return nil
 }
+
+func sendIsolated(ctx context.Context, channel Channel, message string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s panicked: %v", channel.Name(), r)
+		}
+	}()
+	return channel.Send(ctx, message)
+}
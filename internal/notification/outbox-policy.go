@@ -0,0 +1,174 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"log"
+	"strings"
+	"time"
+)
+
+// maxDigestLength keeps a digest below Telegram's 4096 character limit, larger batches are split
+const maxDigestLength = 3500
+
+// scheduleAt is when a message queued at now is first due: once quiet hours end, or else at
+// the end of the current digest window so everything queued within it is folded together
+func (o *Outbox) scheduleAt(now time.Time) time.Time {
+	if until := o.policy.QuietUntil(now); !until.IsZero() {
+		return until
+	}
+	if window := o.policy.DigestWindow; window > 0 {
+		return now.Truncate(window).Add(window)
+	}
+	return now
+}
+
+// deliverChannel sends the due messages of one channel, folded into digests when enabled,
+// and defers whatever is over the channel's hourly cap until a slot frees up. It returns
+// false when the outbox cannot be written, the remaining messages are left for a later round.
+func (o *Outbox) deliverChannel(ctx context.Context, channel string, messages []models.OutboxMessage, now time.Time) bool {
+	if o.policy.DigestWindow > 0 {
+		messages = o.fold(channel, messages, now)
+	}
+
+	limit := o.policy.CapFor(channel)
+	allowance := -1
+	if limit > 0 {
+		sent, err := o.repository.GetSentSince(channel, now.Add(-time.Hour))
+		if err != nil {
+			retryAt := now.Add(o.config.BaseBackoff)
+			log.Printf("⚠️ Failed to count %s notifications, holding them back until %s: %v", channel, retryAt.Format(time.RFC3339), err)
+			return o.hold(messages, retryAt)
+		}
+		allowance = max(limit-len(sent), 0)
+	}
+
+	for i := range messages {
+		if ctx.Err() != nil {
+			return true
+		}
+		if allowance == 0 {
+			return o.throttle(channel, messages[i:], now, limit)
+		}
+		sent, err := o.deliver(ctx, &messages[i])
+		if err != nil {
+			log.Printf("⚠️ %v, pausing delivery", err)
+			return false
+		}
+		if sent && allowance > 0 {
+			allowance--
+		}
+	}
+	return true
+}
+
+// throttle moves messages to when the oldest of the last limit deliveries leaves the hour.
+// It returns false when the outbox cannot be written.
+func (o *Outbox) throttle(channel string, messages []models.OutboxMessage, now time.Time, limit int) bool {
+	sent, err := o.repository.GetSentSince(channel, now.Add(-time.Hour))
+	if err != nil {
+		log.Printf("⚠️ Failed to count %s notifications: %v", channel, err)
+		return o.hold(messages, now.Add(o.config.BaseBackoff))
+	}
+	if len(sent) < limit {
+		// counts moved on in between, the next round retries
+		return true
+	}
+	nextSlot := sent[len(sent)-limit].Add(time.Hour)
+
+	log.Printf("⏸️ %s is over %d notifications per hour, holding %d until %s", channel, limit, len(messages), nextSlot.Format(time.RFC3339))
+	return o.hold(messages, nextSlot)
+}
+
+// hold makes messages due again at until, so later rounds do not pick them straight back up.
+// It returns false when the outbox cannot be written.
+func (o *Outbox) hold(messages []models.OutboxMessage, until time.Time) bool {
+	ids := make([]uint, 0, len(messages))
+	for i := range messages {
+		messages[i].NextAttemptAt = until
+		ids = append(ids, messages[i].ID)
+	}
+	if err := o.repository.Reschedule(ids, until); err != nil {
+		log.Printf("⚠️ Failed to hold back %d notifications, pausing delivery: %v", len(ids), err)
+		return false
+	}
+	return true
+}
+
+// fold replaces messages that were never attempted with digests of them, so a burst of
+// signals arrives as one message. Digests and messages being retried are left as they are.
+func (o *Outbox) fold(channel string, messages []models.OutboxMessage, now time.Time) []models.OutboxMessage {
+	var fresh, result []models.OutboxMessage
+	for _, message := range messages {
+		if message.Attempts == 0 && message.Folded == 0 {
+			fresh = append(fresh, message)
+		} else {
+			result = append(result, message)
+		}
+	}
+	if len(fresh) < 2 {
+		return messages
+	}
+
+	format := o.service.Format(channel)
+	for _, batch := range splitDigest(fresh) {
+		if len(batch) == 1 {
+			result = append(result, batch[0])
+			continue
+		}
+
+		digest, err := o.digest(channel, format, batch, now)
+		if err != nil {
+			log.Printf("⚠️ Failed to fold %d %s notifications into a digest, sending them one by one: %v", len(batch), channel, err)
+			result = append(result, batch...)
+			continue
+		}
+		result = append(result, *digest)
+	}
+	return result
+}
+
+func (o *Outbox) digest(channel string, format string, batch []models.OutboxMessage, now time.Time) (*models.OutboxMessage, error) {
+	parts := make([]string, 0, len(batch)+1)
+	parts = append(parts, Escape(format, fmt.Sprintf("🗞️ Digest of %d notifications", len(batch))))
+	folded := make([]uint, 0, len(batch))
+	for _, message := range batch {
+		parts = append(parts, message.Message)
+		folded = append(folded, message.ID)
+	}
+
+	digest := &models.OutboxMessage{
+		Channel:       channel,
+		Message:       strings.Join(parts, "\n\n"),
+		Status:        models.OutboxPending,
+		NextAttemptAt: now,
+		Folded:        len(batch),
+	}
+	if err := o.repository.Digest(digest, folded); err != nil {
+		return nil, err
+	}
+	log.Printf("🗞️ Folded %d %s notifications into digest %d", len(batch), channel, digest.ID)
+	return digest, nil
+}
+
+// splitDigest groups messages in order so no group's text is longer than maxDigestLength
+func splitDigest(messages []models.OutboxMessage) [][]models.OutboxMessage {
+	var (
+		batches [][]models.OutboxMessage
+		current []models.OutboxMessage
+		length  int
+	)
+	for _, message := range messages {
+		if len(current) > 0 && length+len(message.Message) > maxDigestLength {
+			batches = append(batches, current)
+			current, length = nil, 0
+		}
+		current = append(current, message)
+		length += len(message.Message) + 2
+	}
+	if len(current) > 0 {
+		batches = append(batches, current)
+	}
+	return batches
+}
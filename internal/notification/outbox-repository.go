@@ -0,0 +1,98 @@
+package notification
+
+import (
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"gorm.io/gorm"
+	"time"
+)
+
+type OutboxRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+func (r *OutboxRepository) CreateAll(messages []models.OutboxMessage) error {
+	return r.db.Create(&messages).Error
+}
+
+// GetDue returns pending messages whose next attempt is due, oldest first
+func (r *OutboxRepository) GetDue(now time.Time, limit int) ([]models.OutboxMessage, error) {
+	var messages []models.OutboxMessage
+	err := r.db.Where("status = ? AND next_attempt_at <= ?", models.OutboxPending, now).
+		Order("id").
+		Limit(limit).
+		Find(&messages).Error
+	return messages, err
+}
+
+// Find lists messages newest first, an empty status lists every status
+func (r *OutboxRepository) Find(status string, limit int) ([]models.OutboxMessage, error) {
+	query := r.db.Order("id DESC").Limit(limit)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var messages []models.OutboxMessage
+	err := query.Find(&messages).Error
+	return messages, err
+}
+
+// Digest stores digest and marks every message in folded as digested into it
+func (r *OutboxRepository) Digest(digest *models.OutboxMessage, folded []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(digest).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.OutboxMessage{}).
+			Where("id IN ?", folded).
+			Updates(map[string]interface{}{"status": models.OutboxDigested, "digest_id": digest.ID}).Error
+	})
+}
+
+// GetSentSince returns the send times of channel's messages sent after since, oldest first
+func (r *OutboxRepository) GetSentSince(channel string, since time.Time) ([]time.Time, error) {
+	var sentAt []time.Time
+	err := r.db.Model(&models.OutboxMessage{}).
+		Where("channel = ? AND status = ? AND sent_at > ?", channel, models.OutboxSent, since).
+		Order("sent_at").
+		Pluck("sent_at", &sentAt).Error
+	return sentAt, err
+}
+
+// Reschedule makes the messages in ids due again at nextAttemptAt
+func (r *OutboxRepository) Reschedule(ids []uint, nextAttemptAt time.Time) error {
+	return r.db.Model(&models.OutboxMessage{}).Where("id IN ?", ids).
+		Update("next_attempt_at", nextAttemptAt).Error
+}
+
+func (r *OutboxRepository) Save(message *models.OutboxMessage) error {
+	return r.db.Save(message).Error
+}
+
+// DeleteDeliveredBefore deletes sent and digested messages last updated before before
+func (r *OutboxRepository) DeleteDeliveredBefore(before time.Time) (int64, error) {
+	result := r.db.Where("status IN ? AND updated_at < ?", []string{models.OutboxSent, models.OutboxDigested}, before).
+		Delete(&models.OutboxMessage{})
+	return result.RowsAffected, result.Error
+}
+
+// requeue makes the matching messages pending again with a fresh set of attempts
+func (r *OutboxRepository) requeue(query *gorm.DB, now time.Time) (int64, error) {
+	result := query.Model(&models.OutboxMessage{}).Updates(map[string]interface{}{
+		"status":          models.OutboxPending,
+		"attempts":        0,
+		"next_attempt_at": now,
+	})
+	return result.RowsAffected, result.Error
+}
+
+func (r *OutboxRepository) RequeueDeadByID(id uint, now time.Time) (int64, error) {
+	return r.requeue(r.db.Where("id = ? AND status = ?", id, models.OutboxDead), now)
+}
+
+func (r *OutboxRepository) RequeueDead(now time.Time) (int64, error) {
+	return r.requeue(r.db.Where("status = ?", models.OutboxDead), now)
+}
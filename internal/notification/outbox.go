@@ -0,0 +1,279 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/khorzhenwin/gold-digger/internal/config"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"log"
+	"time"
+)
+
+const (
+	outboxPollInterval = 5 * time.Second
+	outboxBatchSize    = 50
+	// outboxSendTimeout bounds a single delivery, deliveries in flight on shutdown still finish
+	outboxSendTimeout = 15 * time.Second
+	// outboxPruneInterval is how often sent and digested messages past the retention are deleted
+	outboxPruneInterval = time.Hour
+)
+
+var ErrOutboxMessageNotFound = errors.New("no dead-lettered delivery with that id")
+
+// Outbox queues every message durably, one row per channel, before anything is sent.
+// StartDelivery sends them with exponential backoff and dead-letters a delivery after
+// MaxAttempts failures, so an outage delays notifications instead of dropping them.
+type Outbox struct {
+	repository *OutboxRepository
+	service    *Service
+	templates  *Templates
+	config     config.OutboxConfig
+	policy     config.NotificationPolicyConfig
+	wake       chan struct{}
+	now        func() time.Time
+}
+
+// NewOutbox applies policyConfig to every delivery: quiet hours hold messages back, digests
+// fold them together and the hourly caps defer whatever is over the limit
+func NewOutbox(repository *OutboxRepository, service *Service, templates *Templates, outboxConfig *config.OutboxConfig, policyConfig *config.NotificationPolicyConfig) *Outbox {
+	return &Outbox{
+		repository: repository,
+		service:    service,
+		templates:  templates,
+		config:     *outboxConfig,
+		policy:     *policyConfig,
+		wake:       make(chan struct{}, 1),
+		now:        time.Now,
+	}
+}
+
+// TemplateSender renders a message from the templates of kind for each channel before sending it
+type TemplateSender interface {
+	SendTemplate(ctx context.Context, kind string, data MessageData) error
+}
+
+// SendTemplate renders the kind template through notifier when it is a TemplateSender. It sends
+// fallback instead when notifier cannot render templates or the template fails to render.
+func SendTemplate(ctx context.Context, notifier models.Notifier, kind string, data MessageData, fallback string) error {
+	templateSender, ok := notifier.(TemplateSender)
+	if !ok {
+		return notifier.Send(ctx, fallback)
+	}
+
+	err := templateSender.SendTemplate(ctx, kind, data)
+	if errors.Is(err, ErrTemplate) {
+		log.Printf("⚠️ %v, sending the plain message", err)
+		return notifier.Send(ctx, fallback)
+	}
+	return err
+}
+
+// Send queues plain text message for every registered channel, escaped for each one's format.
+// It only fails when the queue cannot be written, delivery failures are retried by StartDelivery.
+func (o *Outbox) Send(_ context.Context, message string) error {
+	return o.enqueue(func(channel string, format string) (string, error) {
+		return Escape(format, message), nil
+	})
+}
+
+// SendTemplate queues the kind template rendered for every registered channel. Nothing is
+// queued when a template fails to render, so callers can fall back to a plain message.
+func (o *Outbox) SendTemplate(_ context.Context, kind string, data MessageData) error {
+	data.Kind = kind
+	return o.enqueue(func(channel string, format string) (string, error) {
+		return o.templates.Render(kind, channel, format, data)
+	})
+}
+
+// Templates are the message templates SendTemplate renders
+func (o *Outbox) Templates() *Templates {
+	return o.templates
+}
+
+// Format is the format messages for channel are rendered in
+func (o *Outbox) Format(channel string) string {
+	return o.service.Format(channel)
+}
+
+func (o *Outbox) enqueue(render func(channel string, format string) (string, error)) error {
+	channels := o.service.Channels()
+	if len(channels) == 0 {
+		return errors.New("no notification channel registered")
+	}
+
+	dueAt := o.scheduleAt(o.now())
+	messages := make([]models.OutboxMessage, 0, len(channels))
+	for _, channel := range channels {
+		message, err := render(channel, o.service.Format(channel))
+		if err != nil {
+			return fmt.Errorf("%w for %s: %v", ErrTemplate, channel, err)
+		}
+		messages = append(messages, models.OutboxMessage{
+			Channel:       channel,
+			Message:       message,
+			Status:        models.OutboxPending,
+			NextAttemptAt: dueAt,
+		})
+	}
+	if err := o.repository.CreateAll(messages); err != nil {
+		return err
+	}
+
+	o.notify()
+	return nil
+}
+
+// Find lists queued messages newest first, an empty status lists every status
+func (o *Outbox) Find(status string, limit int) ([]models.OutboxMessage, error) {
+	return o.repository.Find(status, limit)
+}
+
+// Resend requeues a dead-lettered delivery with a fresh set of attempts
+func (o *Outbox) Resend(id uint) error {
+	requeued, err := o.repository.RequeueDeadByID(id, o.now())
+	if err != nil {
+		return err
+	}
+	if requeued == 0 {
+		return ErrOutboxMessageNotFound
+	}
+	o.notify()
+	return nil
+}
+
+// ResendDead requeues every dead-lettered delivery and returns how many there were
+func (o *Outbox) ResendDead() (int64, error) {
+	requeued, err := o.repository.RequeueDead(o.now())
+	if err != nil {
+		return 0, err
+	}
+	o.notify()
+	return requeued, nil
+}
+
+func (o *Outbox) notify() {
+	select {
+	case o.wake <- struct{}{}:
+	default:
+	}
+}
+
+// StartDelivery sends due messages until ctx is cancelled. Whatever is still pending then
+// stays queued and is delivered after the next start.
+func (o *Outbox) StartDelivery(ctx context.Context) error {
+	log.Println("📬 Notification outbox delivery started")
+
+	ticker := time.NewTicker(outboxPollInterval)
+	defer ticker.Stop()
+
+	var prunedAt time.Time
+	for {
+		o.deliverDue(ctx)
+		if o.config.Retention > 0 && o.now().Sub(prunedAt) >= outboxPruneInterval {
+			o.prune()
+			prunedAt = o.now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		case <-o.wake:
+		}
+	}
+}
+
+func (o *Outbox) deliverDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		now := o.now()
+		// anything due during quiet hours waits for them to end
+		if !o.policy.QuietUntil(now).IsZero() {
+			return
+		}
+
+		due, err := o.repository.GetDue(now, outboxBatchSize)
+		if err != nil {
+			log.Printf("⚠️ Failed to read the notification outbox: %v", err)
+			return
+		}
+
+		var channels []string
+		byChannel := make(map[string][]models.OutboxMessage)
+		for _, message := range due {
+			if _, ok := byChannel[message.Channel]; !ok {
+				channels = append(channels, message.Channel)
+			}
+			byChannel[message.Channel] = append(byChannel[message.Channel], message)
+		}
+		for _, channel := range channels {
+			// the outbox cannot be written, leave everything for the next round
+			if !o.deliverChannel(ctx, channel, byChannel[channel], now) {
+				return
+			}
+		}
+
+		if len(due) < outboxBatchSize {
+			return
+		}
+	}
+}
+
+// deliver reports whether message was sent. The attempt is recorded before sending, due again
+// only after the backoff, so a send whose outcome cannot be saved is not repeated straight away.
+// It returns an error when the outbox cannot be written, nothing is sent when recording fails.
+func (o *Outbox) deliver(ctx context.Context, message *models.OutboxMessage) (bool, error) {
+	message.Attempts++
+	message.NextAttemptAt = o.now().Add(o.backoff(message.Attempts))
+	if err := o.repository.Save(message); err != nil {
+		return false, fmt.Errorf("failed to record the attempt on notification %d: %w", message.ID, err)
+	}
+
+	sendCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), outboxSendTimeout)
+	err := o.service.SendTo(sendCtx, message.Channel, message.Message)
+	cancel()
+
+	now := o.now()
+	if err == nil {
+		message.Status = models.OutboxSent
+		message.SentAt = &now
+		message.LastError = ""
+	} else {
+		message.LastError = err.Error()
+		if message.Attempts >= o.config.MaxAttempts {
+			message.Status = models.OutboxDead
+			log.Printf("💀 Dead-lettered %s notification %d after %d attempts: %v", message.Channel, message.ID, message.Attempts, err)
+		} else {
+			log.Printf("⚠️ %s notification %d failed, retrying at %s: %v", message.Channel, message.ID, message.NextAttemptAt.Format(time.RFC3339), err)
+		}
+	}
+
+	if saveErr := o.repository.Save(message); saveErr != nil {
+		return err == nil, fmt.Errorf("failed to record the outcome of notification %d: %w", message.ID, saveErr)
+	}
+	return err == nil, nil
+}
+
+// prune deletes sent and digested messages older than the retention, dead ones stay for a resend
+func (o *Outbox) prune() {
+	deleted, err := o.repository.DeleteDeliveredBefore(o.now().Add(-o.config.Retention))
+	if err != nil {
+		log.Printf("⚠️ Failed to prune the notification outbox: %v", err)
+		return
+	}
+	if deleted > 0 {
+		log.Printf("🧹 Pruned %d delivered notifications older than %s", deleted, o.config.Retention)
+	}
+}
+
+// backoff doubles BaseBackoff for every attempt after the first, capped at MaxBackoff
+func (o *Outbox) backoff(attempts int) time.Duration {
+	backoff := o.config.BaseBackoff
+	for i := 1; i < attempts && backoff < o.config.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > o.config.MaxBackoff {
+		backoff = o.config.MaxBackoff
+	}
+	return backoff
+}
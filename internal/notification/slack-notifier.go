@@ -0,0 +1,32 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"net/http"
+)
+
+type SlackNotifier struct {
+	config models.SlackNotifier
+	client *http.Client
+}
+
+func NewSlackNotifier(config models.SlackNotifier, client *http.Client) *SlackNotifier {
+	return &SlackNotifier{config: config, client: client}
+}
+
+func (n *SlackNotifier) Name() string {
+	return "slack"
+}
+
+func (n *SlackNotifier) Format() string {
+	return FormatSlack
+}
+
+func (n *SlackNotifier) Send(ctx context.Context, message string) error {
+	if err := postJSON(ctx, n.client, n.config.WebhookURL, map[string]string{"text": message}, nil); err != nil {
+		return fmt.Errorf("failed to send slack message: %w", err)
+	}
+	return nil
+}
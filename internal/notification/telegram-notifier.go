@@ -0,0 +1,42 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"net/http"
+)
+
+type TelegramNotifier struct {
+	config models.TelegramNotifier
+	client *http.Client
+}
+
+func NewTelegramNotifier(config models.TelegramNotifier, client *http.Client) *TelegramNotifier {
+	return &TelegramNotifier{config: config, client: client}
+}
+
+func (n *TelegramNotifier) Name() string {
+	return "telegram"
+}
+
+// Format follows the configured parse mode
+func (n *TelegramNotifier) Format() string {
+	return n.config.ParseMode
+}
+
+func (n *TelegramNotifier) Send(ctx context.Context, message string) error {
+	url := fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage", n.config.BotToken)
+
+	payload := map[string]string{
+		"chat_id": n.config.ChatID,
+		"text":    message,
+	}
+	if n.config.ParseMode != "" {
+		payload["parse_mode"] = n.config.ParseMode
+	}
+	if err := postJSON(ctx, n.client, url, payload, nil); err != nil {
+		return fmt.Errorf("failed to send telegram message: %w", err)
+	}
+	return nil
+}
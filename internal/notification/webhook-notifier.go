@@ -0,0 +1,56 @@
+package notification
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"net/http"
+	"time"
+)
+
+const webhookSignatureHeader = "X-Gold-Digger-Signature"
+
+type webhookPayload struct {
+	Message string    `json:"message"`
+	SentAt  time.Time `json:"sent_at"`
+}
+
+type WebhookNotifier struct {
+	config models.WebhookNotifier
+	client *http.Client
+}
+
+func NewWebhookNotifier(config models.WebhookNotifier, client *http.Client) *WebhookNotifier {
+	return &WebhookNotifier{config: config, client: client}
+}
+
+func (n *WebhookNotifier) Name() string {
+	return "webhook"
+}
+
+func (n *WebhookNotifier) Format() string {
+	return FormatPlain
+}
+
+func (n *WebhookNotifier) Send(ctx context.Context, message string) error {
+	body, err := json.Marshal(webhookPayload{Message: message, SentAt: time.Now().UTC()})
+	if err != nil {
+		return err
+	}
+
+	var headers map[string]string
+	if n.config.Secret != "" {
+		mac := hmac.New(sha256.New, []byte(n.config.Secret))
+		mac.Write(body)
+		headers = map[string]string{webhookSignatureHeader: "sha256=" + hex.EncodeToString(mac.Sum(nil))}
+	}
+
+	if err := postBody(ctx, n.client, n.config.URL, body, headers); err != nil {
+		return fmt.Errorf("failed to send webhook: %w", err)
+	}
+	return nil
+}
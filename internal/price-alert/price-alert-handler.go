@@ -0,0 +1,177 @@
+package price_alert
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"gorm.io/gorm"
+	"net/http"
+	"strconv"
+)
+
+type Handler struct {
+	Service *Service
+}
+
+func RegisterRoutes(r chi.Router, service *Service) {
+	h := &Handler{Service: service}
+
+	r.Get("/watchlist/{id}/alerts", h.GetAlertsHandler)
+	r.Post("/watchlist/{id}/alerts", h.CreateAlertHandler)
+	r.Put("/watchlist/{id}/alerts/{alertId}", h.UpdateAlertHandler)
+	r.Delete("/watchlist/{id}/alerts/{alertId}", h.DeleteAlertHandler)
+}
+
+// GetAlertsHandler handles GET /watchlist/{id}/alerts
+// @Summary      List price alerts of a watchlist item
+// @Description  Returns the price target, stop and percent alerts of a watchlist item with their armed state
+// @Tags         watchlist
+// @Produce      json
+// @Param        id   path      string  true  "Ticker ID"
+// @Success      200  {array}   models.PriceAlert
+// @Failure      404  {string}  string  "not found"
+// @Router       /api/v1/watchlist/{id}/alerts [get]
+func (h *Handler) GetAlertsHandler(w http.ResponseWriter, r *http.Request) {
+	tickerID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	alerts, err := h.Service.FindByTicker(tickerID)
+	if err != nil {
+		writeError(w, err, "Failed to retrieve alerts")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(alerts)
+	if err != nil {
+		return
+	}
+}
+
+// CreateAlertHandler handles POST /watchlist/{id}/alerts
+// @Summary      Create a price alert
+// @Description  Adds an above, below, pct_up or pct_down alert to a watchlist item. Percent alerts without a reference_price use the next polled price. Set repeat to re-arm once the price crosses back.
+// @Tags         watchlist
+// @Accept       json
+// @Produce      json
+// @Param        id     path      string             true  "Ticker ID"
+// @Param        alert  body      models.PriceAlert  true  "Alert to add"
+// @Success      201    {object}  models.PriceAlert
+// @Failure      400    {string}  string  "bad request"
+// @Failure      404    {string}  string  "not found"
+// @Router       /api/v1/watchlist/{id}/alerts [post]
+func (h *Handler) CreateAlertHandler(w http.ResponseWriter, r *http.Request) {
+	tickerID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var alert models.PriceAlert
+	if err := json.NewDecoder(r.Body).Decode(&alert); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Service.Create(tickerID, &alert); err != nil {
+		writeError(w, err, "Failed to create alert")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	err := json.NewEncoder(w).Encode(alert)
+	if err != nil {
+		return
+	}
+}
+
+// UpdateAlertHandler handles PUT /watchlist/{id}/alerts/{alertId}
+// @Summary      Update a price alert
+// @Description  Replaces the rule of an alert and re-arms it
+// @Tags         watchlist
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string             true  "Ticker ID"
+// @Param        alertId  path      string             true  "Alert ID"
+// @Param        alert    body      models.PriceAlert  true  "Updated alert"
+// @Success      200      {object}  models.PriceAlert
+// @Failure      400      {string}  string  "bad request"
+// @Failure      404      {string}  string  "not found"
+// @Router       /api/v1/watchlist/{id}/alerts/{alertId} [put]
+func (h *Handler) UpdateAlertHandler(w http.ResponseWriter, r *http.Request) {
+	tickerID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+	alertID, ok := parseID(w, r, "alertId")
+	if !ok {
+		return
+	}
+
+	var alert models.PriceAlert
+	if err := json.NewDecoder(r.Body).Decode(&alert); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	updated, err := h.Service.Update(tickerID, alertID, alert)
+	if err != nil {
+		writeError(w, err, "Failed to update alert")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(updated)
+	if err != nil {
+		return
+	}
+}
+
+// DeleteAlertHandler handles DELETE /watchlist/{id}/alerts/{alertId}
+// @Summary      Delete a price alert
+// @Tags         watchlist
+// @Param        id       path  string  true  "Ticker ID"
+// @Param        alertId  path  string  true  "Alert ID"
+// @Success      204  {string}  string  "no content"
+// @Failure      404  {string}  string  "not found"
+// @Router       /api/v1/watchlist/{id}/alerts/{alertId} [delete]
+func (h *Handler) DeleteAlertHandler(w http.ResponseWriter, r *http.Request) {
+	tickerID, ok := parseID(w, r, "id")
+	if !ok {
+		return
+	}
+	alertID, ok := parseID(w, r, "alertId")
+	if !ok {
+		return
+	}
+
+	if err := h.Service.Delete(tickerID, alertID); err != nil {
+		writeError(w, err, "Failed to delete alert")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func parseID(w http.ResponseWriter, r *http.Request, param string) (uint, bool) {
+	id, err := strconv.ParseUint(chi.URLParam(r, param), 10, 64)
+	if err != nil || id == 0 {
+		http.Error(w, "Invalid ID", http.StatusBadRequest)
+		return 0, false
+	}
+	return uint(id), true
+}
+
+func writeError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, ErrInvalidPriceAlert):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		http.Error(w, "Record not found", http.StatusNotFound)
+	default:
+		http.Error(w, message, http.StatusInternalServerError)
+	}
+}
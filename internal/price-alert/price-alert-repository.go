@@ -0,0 +1,72 @@
+package price_alert
+
+import (
+	"errors"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) Create(alert *models.PriceAlert) error {
+	return r.db.Create(alert).Error
+}
+
+func (r *Repository) GetAll() ([]models.PriceAlert, error) {
+	var alerts []models.PriceAlert
+	err := r.db.Order("id").Find(&alerts).Error
+	return alerts, err
+}
+
+func (r *Repository) GetByTicker(tickerID uint) ([]models.PriceAlert, error) {
+	var alerts []models.PriceAlert
+	err := r.db.Where("ticker_id = ?", tickerID).Order("id").Find(&alerts).Error
+	return alerts, err
+}
+
+// GetByID returns nil when the alert does not exist on the ticker
+func (r *Repository) GetByID(tickerID uint, id uint) (*models.PriceAlert, error) {
+	var alert models.PriceAlert
+	err := r.db.Where("ticker_id = ?", tickerID).First(&alert, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &alert, err
+}
+
+func (r *Repository) Save(alert *models.PriceAlert) error {
+	return r.db.Save(alert).Error
+}
+
+// SaveState writes only the trigger state evaluation changes, so it cannot undo a concurrent edit of the rule
+func (r *Repository) SaveState(alert models.PriceAlert) error {
+	return r.db.Model(&models.PriceAlert{}).Where("id = ?", alert.ID).Updates(map[string]interface{}{
+		"armed":         alert.Armed,
+		"trigger_count": alert.TriggerCount,
+		"triggered_at":  alert.TriggeredAt,
+	}).Error
+}
+
+// SetReferencePrice anchors a percent alert to the first price seen, unless a reference was
+// set meanwhile, e.g. by an update
+func (r *Repository) SetReferencePrice(id uint, referencePrice float64) error {
+	return r.db.Model(&models.PriceAlert{}).Where("id = ? AND reference_price = 0", id).
+		Update("reference_price", referencePrice).Error
+}
+
+func (r *Repository) Delete(tickerID uint, id uint) error {
+	result := r.db.Where("ticker_id = ?", tickerID).Delete(&models.PriceAlert{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
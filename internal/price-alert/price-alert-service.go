@@ -0,0 +1,312 @@
+package price_alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"github.com/khorzhenwin/gold-digger/internal/notification"
+	"github.com/khorzhenwin/gold-digger/internal/watchlist"
+	"gorm.io/gorm"
+	"log"
+	"sync"
+	"time"
+)
+
+// notificationTimeout bounds a single send, sends are detached from shutdown so in-flight alerts finish
+const notificationTimeout = 15 * time.Second
+
+// alertCacheTTL bounds how long a renamed or removed watchlist item keeps its old alerts in the evaluator
+const alertCacheTTL = time.Minute
+
+var ErrInvalidPriceAlert = errors.New("invalid price alert")
+
+type Service struct {
+	repository       *Repository
+	watchlistService *watchlist.Service
+	notifier         models.Notifier
+
+	// alerts caches every alert by the current symbol of its ticker for the evaluator, nil until
+	// loaded, after a write or once loadedAt is older than alertCacheTTL
+	mu       sync.Mutex
+	alerts   map[string][]models.PriceAlert
+	loadedAt time.Time
+}
+
+func NewService(repository *Repository, watchlistService *watchlist.Service, notifier models.Notifier) *Service {
+	return &Service{
+		repository:       repository,
+		watchlistService: watchlistService,
+		notifier:         notifier,
+	}
+}
+
+func (s *Service) FindByTicker(tickerID uint) ([]models.PriceAlert, error) {
+	ticker, err := s.findTicker(tickerID)
+	if err != nil {
+		return nil, err
+	}
+	alerts, err := s.repository.GetByTicker(tickerID)
+	if err != nil {
+		return nil, err
+	}
+	// the stored symbol is a snapshot from creation, the ticker may have been renamed since
+	for i := range alerts {
+		alerts[i].Symbol = ticker.Symbol
+	}
+	return alerts, nil
+}
+
+// Create arms a new alert on the ticker
+func (s *Service) Create(tickerID uint, alert *models.PriceAlert) error {
+	ticker, err := s.findTicker(tickerID)
+	if err != nil {
+		return err
+	}
+	if err := validate(*alert); err != nil {
+		return err
+	}
+
+	alert.ID = 0
+	alert.TickerID = ticker.ID
+	alert.Symbol = ticker.Symbol
+	alert.Armed = true
+	alert.TriggerCount = 0
+	alert.TriggeredAt = nil
+
+	defer s.invalidate()
+	return s.repository.Create(alert)
+}
+
+// Update replaces the rule of an alert and re-arms it
+func (s *Service) Update(tickerID uint, id uint, updated models.PriceAlert) (*models.PriceAlert, error) {
+	if err := validate(updated); err != nil {
+		return nil, err
+	}
+	existing, err := s.repository.GetByID(tickerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	existing.Condition = updated.Condition
+	existing.Level = updated.Level
+	existing.ReferencePrice = updated.ReferencePrice
+	existing.Repeat = updated.Repeat
+	existing.Note = updated.Note
+	existing.Armed = true
+
+	defer s.invalidate()
+	if err := s.repository.Save(existing); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+func (s *Service) Delete(tickerID uint, id uint) error {
+	defer s.invalidate()
+	return s.repository.Delete(tickerID, id)
+}
+
+func (s *Service) findTicker(tickerID uint) (*models.Ticker, error) {
+	ticker, err := s.watchlistService.FindByID(tickerID)
+	if err != nil {
+		return nil, err
+	}
+	if ticker == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return ticker, nil
+}
+
+func validate(alert models.PriceAlert) error {
+	switch alert.Condition {
+	case models.PriceAlertAbove, models.PriceAlertBelow, models.PriceAlertPctUp, models.PriceAlertPctDown:
+	default:
+		return fmt.Errorf("%w: condition must be above, below, pct_up or pct_down", ErrInvalidPriceAlert)
+	}
+	if alert.Level <= 0 {
+		return fmt.Errorf("%w: level must be positive", ErrInvalidPriceAlert)
+	}
+	if alert.Condition == models.PriceAlertPctDown && alert.Level >= 100 {
+		return fmt.Errorf("%w: pct_down level must be below 100", ErrInvalidPriceAlert)
+	}
+	if alert.ReferencePrice < 0 {
+		return fmt.Errorf("%w: reference_price must not be negative", ErrInvalidPriceAlert)
+	}
+	return nil
+}
+
+func (s *Service) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.alerts = nil
+}
+
+// alertsFor returns the alerts of the ticker currently named symbol. Alerts are matched to
+// tickers by TickerID, so renamed items keep their alerts and alerts of removed items never fire.
+func (s *Service) alertsFor(symbol string) ([]models.PriceAlert, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.alerts == nil || time.Since(s.loadedAt) > alertCacheTTL {
+		all, err := s.repository.GetAll()
+		if err != nil {
+			return nil, err
+		}
+		tickers, err := s.watchlistService.FindAll()
+		if err != nil {
+			return nil, err
+		}
+		symbols := make(map[uint]string, len(tickers))
+		for _, ticker := range tickers {
+			symbols[ticker.ID] = ticker.Symbol
+		}
+
+		s.alerts = make(map[string][]models.PriceAlert)
+		for _, alert := range all {
+			current, ok := symbols[alert.TickerID]
+			if !ok {
+				continue
+			}
+			alert.Symbol = current
+			s.alerts[current] = append(s.alerts[current], alert)
+		}
+		s.loadedAt = time.Now()
+	}
+
+	alerts := make([]models.PriceAlert, len(s.alerts[symbol]))
+	copy(alerts, s.alerts[symbol])
+	return alerts, nil
+}
+
+// remember updates the cached state of alert unless a write invalidated the cache meanwhile
+func (s *Service) remember(alert models.PriceAlert) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, cached := range s.alerts[alert.Symbol] {
+		if cached.ID == alert.ID {
+			s.alerts[alert.Symbol][i] = alert
+		}
+	}
+}
+
+// StartEvaluator checks every saved price against the alerts of its symbol.
+// It blocks until input is closed, which happens once the poller has drained on shutdown.
+func (s *Service) StartEvaluator(ctx context.Context, input <-chan models.TickerPrice) error {
+	log.Println("🎯 Price alert evaluator started")
+	for price := range input {
+		s.evaluate(ctx, price)
+	}
+	log.Println("🛑 Price alert input closed, stopping")
+	return ctx.Err()
+}
+
+func (s *Service) evaluate(ctx context.Context, price models.TickerPrice) {
+	alerts, err := s.alertsFor(price.Symbol)
+	if err != nil {
+		log.Printf("⚠️ Failed to load price alerts: %v", err)
+		return
+	}
+
+	for _, alert := range alerts {
+		next, fired := step(alert, price)
+		if next == alert {
+			continue
+		}
+
+		if fired {
+			message := formatAlertMessage(alert, price)
+			log.Println(message)
+
+			sendCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), notificationTimeout)
+			err := s.send(sendCtx, alert, price, message)
+			cancel()
+			if err != nil {
+				// stay armed so the next price retries the notification
+				log.Printf("⚠️ Failed to send price alert %d: %v", alert.ID, err)
+				continue
+			}
+		}
+
+		if err := s.repository.SaveState(next); err != nil {
+			log.Printf("⚠️ Failed to save price alert %d: %v", alert.ID, err)
+		}
+		if alert.ReferencePrice == 0 && next.ReferencePrice != 0 {
+			if err := s.repository.SetReferencePrice(next.ID, next.ReferencePrice); err != nil {
+				log.Printf("⚠️ Failed to save the reference price of price alert %d: %v", alert.ID, err)
+			}
+		}
+		s.remember(next)
+	}
+}
+
+// send renders the price alert template, message is sent when that is not possible
+func (s *Service) send(ctx context.Context, alert models.PriceAlert, price models.TickerPrice, message string) error {
+	return notification.SendTemplate(ctx, s.notifier, notification.KindPriceAlert, notification.MessageData{
+		Symbol:         alert.Symbol,
+		Notes:          alert.Note,
+		Price:          price.Price,
+		Condition:      alert.Condition,
+		Level:          alert.Level,
+		ReferencePrice: alert.ReferencePrice,
+	}, message)
+}
+
+// step applies price to alert, returning its next state and whether it fired
+func step(alert models.PriceAlert, price models.TickerPrice) (models.PriceAlert, bool) {
+	isPct := alert.Condition == models.PriceAlertPctUp || alert.Condition == models.PriceAlertPctDown
+	if isPct && alert.ReferencePrice == 0 {
+		alert.ReferencePrice = price.Price
+		return alert, false
+	}
+
+	holds := conditionHolds(alert, price.Price)
+	switch {
+	case alert.Armed && holds:
+		at := price.Timestamp
+		alert.Armed = false
+		alert.TriggerCount++
+		alert.TriggeredAt = &at
+		return alert, true
+	case !alert.Armed && alert.Repeat && !holds:
+		// the price crossed back, so a repeating alert may fire again
+		alert.Armed = true
+	}
+	return alert, false
+}
+
+func conditionHolds(alert models.PriceAlert, price float64) bool {
+	switch alert.Condition {
+	case models.PriceAlertAbove:
+		return price >= alert.Level
+	case models.PriceAlertBelow:
+		return price <= alert.Level
+	case models.PriceAlertPctUp:
+		return price >= alert.ReferencePrice*(1+alert.Level/100)
+	case models.PriceAlertPctDown:
+		return price <= alert.ReferencePrice*(1-alert.Level/100)
+	default:
+		return false
+	}
+}
+
+func formatAlertMessage(alert models.PriceAlert, price models.TickerPrice) string {
+	var message string
+	switch alert.Condition {
+	case models.PriceAlertAbove:
+		message = fmt.Sprintf("🎯 %s crossed above %.2f, now %.2f", alert.Symbol, alert.Level, price.Price)
+	case models.PriceAlertBelow:
+		message = fmt.Sprintf("🛑 %s crossed below %.2f, now %.2f", alert.Symbol, alert.Level, price.Price)
+	case models.PriceAlertPctUp:
+		message = fmt.Sprintf("📈 %s is up %.2f%% from %.2f, now %.2f", alert.Symbol, alert.Level, alert.ReferencePrice, price.Price)
+	case models.PriceAlertPctDown:
+		message = fmt.Sprintf("📉 %s is down %.2f%% from %.2f, now %.2f", alert.Symbol, alert.Level, alert.ReferencePrice, price.Price)
+	}
+	if alert.Note != "" {
+		message += " - " + alert.Note
+	}
+	return message
+}
@@ -0,0 +1,169 @@
+package price_bus
+
+import (
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"log"
+	"sync"
+)
+
+// OverflowPolicy decides what Publish does when a subscriber's buffer is full
+type OverflowPolicy int
+
+const (
+	// Block makes the publisher wait for the subscriber, so no tick is ever lost
+	Block OverflowPolicy = iota
+	// DropOldest discards the oldest buffered tick to make room for the new one
+	DropOldest
+)
+
+// Bus fans out every persisted TickerPrice to all of its subscribers
+type Bus struct {
+	mu     sync.RWMutex
+	subs   map[uint64]*Subscription
+	nextID uint64
+	closed bool
+}
+
+type Subscription struct {
+	id      uint64
+	name    string
+	policy  OverflowPolicy
+	ch      chan models.TickerPrice
+	done    chan struct{}
+	once    sync.Once
+	mu      sync.Mutex
+	dropped uint64
+	closed  bool
+}
+
+func NewBus() *Bus {
+	return &Bus{subs: make(map[uint64]*Subscription)}
+}
+
+func (b *Bus) Subscribe(name string, buffer int, policy OverflowPolicy) *Subscription {
+	if buffer < 1 {
+		buffer = 1
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.nextID++
+	sub := &Subscription{
+		id:     b.nextID,
+		name:   name,
+		policy: policy,
+		ch:     make(chan models.TickerPrice, buffer),
+		done:   make(chan struct{}),
+	}
+
+	// subscribing after Close hands back an already drained subscription
+	if b.closed {
+		sub.closed = true
+		close(sub.ch)
+		sub.once.Do(func() { close(sub.done) })
+		return sub
+	}
+
+	b.subs[sub.id] = sub
+	return sub
+}
+
+// Unsubscribe removes the subscription and closes its channel
+func (b *Bus) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	delete(b.subs, sub.id)
+	b.mu.Unlock()
+
+	// release a publisher that may be blocked on this subscriber before taking its lock
+	sub.once.Do(func() { close(sub.done) })
+
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	if !sub.closed {
+		sub.closed = true
+		close(sub.ch)
+	}
+}
+
+// Close unsubscribes everyone, subscribers still receive what is buffered before their channel closes
+func (b *Bus) Close() {
+	b.mu.Lock()
+	b.closed = true
+	subs := make([]*Subscription, 0, len(b.subs))
+	for _, sub := range b.subs {
+		subs = append(subs, sub)
+	}
+	b.mu.Unlock()
+
+	for _, sub := range subs {
+		b.Unsubscribe(sub)
+	}
+}
+
+// Publish delivers price to every subscriber according to its overflow policy.
+// Blocking subscribers apply backpressure to the caller once their buffer is full.
+func (b *Bus) Publish(price models.TickerPrice) {
+	b.mu.RLock()
+	subs := make([]*Subscription, 0, len(b.subs))
+	for _, sub := range b.subs {
+		subs = append(subs, sub)
+	}
+	b.mu.RUnlock()
+
+	for _, sub := range subs {
+		sub.deliver(price)
+	}
+}
+
+func (s *Subscription) C() <-chan models.TickerPrice {
+	return s.ch
+}
+
+func (s *Subscription) Name() string {
+	return s.name
+}
+
+// Dropped reports how many ticks were discarded for a DropOldest subscriber
+func (s *Subscription) Dropped() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.dropped
+}
+
+func (s *Subscription) deliver(price models.TickerPrice) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+
+	switch s.policy {
+	case DropOldest:
+		for {
+			select {
+			case s.ch <- price:
+				return
+			default:
+			}
+			select {
+			case <-s.ch:
+				s.dropped++
+				if s.dropped%100 == 1 {
+					log.Printf("⚠️ Subscriber %s is lagging, dropped %d ticks so far", s.name, s.dropped)
+				}
+			default:
+			}
+		}
+	default:
+		select {
+		case s.ch <- price:
+		default:
+			log.Printf("⏳ Subscriber %s buffer full, waiting before publishing %s", s.name, price.Symbol)
+			select {
+			case s.ch <- price:
+			case <-s.done:
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package signals
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/chi/v5"
+	"github.com/khorzhenwin/gold-digger/internal/ticker-price"
+	"net/http"
+	"strconv"
+)
+
+type Handler struct {
+	Service Service
+}
+
+func RegisterRoutes(r chi.Router, service *Service) {
+	h := &Handler{Service: *service}
+
+	r.Route("/signals", func(r chi.Router) {
+		r.Get("/", h.GetSignals)
+	})
+}
+
+// GetSignals handles GET /signals
+// @Summary      List fired signals
+// @Description  Returns stored signals newest first, with whether their notification was sent. Defaults to the last 7 days.
+// @Tags         signals
+// @Produce      json
+// @Param        symbol    query  string  false  "Ticker Symbol"
+// @Param        strategy  query  string  false  "Strategy name"
+// @Param        from      query  string  false  "Start time (RFC3339)"
+// @Param        to        query  string  false  "End time (RFC3339)"
+// @Param        limit     query  int     false  "Max signals returned, 100 by default and at most 1000"
+// @Success      200       {array}   models.Signal
+// @Failure      400       {string}  string  "bad request"
+// @Router       /api/v1/signals [get]
+func (h *Handler) GetSignals(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	from, to, err := ticker_price.ParseTimeRange(query.Get("from"), query.Get("to"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	limit := 0
+	if value := query.Get("limit"); value != "" {
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			http.Error(w, fmt.Sprintf("invalid 'limit' %q", value), http.StatusBadRequest)
+			return
+		}
+	}
+
+	signals, err := h.Service.Find(Filter{
+		Symbol:   query.Get("symbol"),
+		Strategy: query.Get("strategy"),
+		From:     from,
+		To:       to,
+		Limit:    limit,
+	})
+	if err != nil {
+		http.Error(w, "Failed to retrieve signals", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(signals)
+	if err != nil {
+		return
+	}
+}
@@ -0,0 +1,44 @@
+package signals
+
+import (
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"gorm.io/gorm"
+	"time"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) Create(signal *models.Signal) error {
+	return r.db.Create(signal).Error
+}
+
+func (r *Repository) MarkSent(id uint, sentAt time.Time) error {
+	return r.db.Model(&models.Signal{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"sent": true, "sent_at": sentAt, "send_error": ""}).Error
+}
+
+func (r *Repository) MarkFailed(id uint, sendError string) error {
+	return r.db.Model(&models.Signal{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"sent": false, "send_error": sendError}).Error
+}
+
+// Find returns signals created between filter.From and filter.To, newest first
+func (r *Repository) Find(filter Filter) ([]models.Signal, error) {
+	query := r.db.Where("created_at BETWEEN ? AND ?", filter.From, filter.To)
+	if filter.Symbol != "" {
+		query = query.Where("symbol = ?", filter.Symbol)
+	}
+	if filter.Strategy != "" {
+		query = query.Where("strategy = ?", filter.Strategy)
+	}
+
+	var signals []models.Signal
+	err := query.Order("created_at DESC").Limit(filter.Limit).Find(&signals).Error
+	return signals, err
+}
@@ -0,0 +1,65 @@
+package signals
+
+import (
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"github.com/khorzhenwin/gold-digger/internal/strategy"
+	"time"
+)
+
+const (
+	DefaultLimit = 100
+	MaxLimit     = 1000
+)
+
+type Filter struct {
+	Symbol   string
+	Strategy string
+	From     time.Time
+	To       time.Time
+	Limit    int
+}
+
+type Service struct {
+	repository *Repository
+}
+
+func NewService(repository *Repository) *Service {
+	return &Service{repository: repository}
+}
+
+// Record stores a signal that just fired, before its notification is attempted
+func (s *Service) Record(signal strategy.Signal) (*models.Signal, error) {
+	record := &models.Signal{
+		Symbol:      signal.Symbol,
+		Strategy:    signal.Strategy,
+		Direction:   string(signal.Side),
+		Strength:    signal.Strength,
+		Reason:      signal.Reason,
+		Price:       signal.Price,
+		ChangePct:   signal.ChangePct,
+		WindowStart: signal.WindowStart,
+		WindowEnd:   signal.WindowEnd,
+	}
+	if err := s.repository.Create(record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// MarkSent records the outcome of a signal's notification, sendErr is nil when it was delivered
+func (s *Service) MarkSent(id uint, sendErr error) error {
+	if sendErr != nil {
+		return s.repository.MarkFailed(id, sendErr.Error())
+	}
+	return s.repository.MarkSent(id, time.Now())
+}
+
+func (s *Service) Find(filter Filter) ([]models.Signal, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultLimit
+	}
+	if filter.Limit > MaxLimit {
+		filter.Limit = MaxLimit
+	}
+	return s.repository.Find(filter)
+}
@@ -0,0 +1,87 @@
+package strategy
+
+import (
+	"fmt"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"math"
+)
+
+const MomentumStrategyName = "momentum"
+
+// MomentumStrategy fires when the window moved at least MinChange overall
+// with at least MinSteps ticks in the same direction.
+type MomentumStrategy struct {
+	MinChange  float64
+	MinSteps   int
+	MinSamples int
+}
+
+func NewMomentumStrategy() *MomentumStrategy {
+	return &MomentumStrategy{MinChange: 0.02, MinSteps: 4, MinSamples: 5}
+}
+
+// NewMomentumStrategyWithOptions overrides the defaults with any non-zero option.
+// A window shorter than MinSamples lowers MinSamples so the strategy can still fire.
+func NewMomentumStrategyWithOptions(options Options) Strategy {
+	s := NewMomentumStrategy()
+	if options.MinChange > 0 {
+		s.MinChange = options.MinChange
+	}
+	if options.MinSteps > 0 {
+		s.MinSteps = options.MinSteps
+	}
+	if options.WindowSize > 0 && options.WindowSize < s.MinSamples {
+		s.MinSamples = options.WindowSize
+	}
+	return s
+}
+
+func (s *MomentumStrategy) Name() string {
+	return MomentumStrategyName
+}
+
+func (s *MomentumStrategy) Evaluate(symbol string, window []models.TickerPrice) []Signal {
+	if len(window) < s.MinSamples {
+		return nil
+	}
+
+	oldest := window[0]
+	latest := window[len(window)-1]
+	if oldest.Price == 0 {
+		return nil
+	}
+	totalChange := (latest.Price - oldest.Price) / oldest.Price
+
+	increaseCount, decreaseCount := 0, 0
+	for i := 1; i < len(window); i++ {
+		if window[i].Price > window[i-1].Price {
+			increaseCount++
+		} else if window[i].Price < window[i-1].Price {
+			decreaseCount++
+		}
+	}
+
+	signal := Signal{
+		Symbol:   symbol,
+		Strategy: s.Name(),
+		Price:    latest.Price,
+		// a move of exactly MinChange scores 0.5, twice MinChange or more scores 1
+		Strength:    math.Min(1, math.Abs(totalChange)/(2*s.MinChange)),
+		ChangePct:   totalChange * 100,
+		WindowStart: oldest.Timestamp,
+		WindowEnd:   latest.Timestamp,
+	}
+
+	switch {
+	case totalChange >= s.MinChange && increaseCount >= s.MinSteps:
+		signal.Side = SideBuy
+		signal.Reason = fmt.Sprintf("Strong uptrend (%.2f%% increase, %d up steps)", signal.ChangePct, increaseCount)
+	case totalChange <= -s.MinChange && decreaseCount >= s.MinSteps:
+		signal.Side = SideDip
+		signal.Reason = fmt.Sprintf("Strong downtrend (%.2f%% decrease, %d down steps)", signal.ChangePct, decreaseCount)
+	default:
+		return nil
+	}
+
+	return []Signal{signal}
+}
@@ -0,0 +1,163 @@
+package strategy
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Options tunes a strategy for one symbol, zero fields keep the strategy's defaults
+type Options struct {
+	MinChange  float64
+	MinSteps   int
+	WindowSize int
+}
+
+func (o Options) isZero() bool {
+	return o == Options{}
+}
+
+// Factory builds a strategy tuned with options
+type Factory func(options Options) Strategy
+
+// Registry holds the available strategies and which of them are enabled per symbol
+type Registry struct {
+	mu         sync.RWMutex
+	strategies map[string]Strategy
+	factories  map[string]Factory
+	enabled    map[string][]string
+	tuned      map[string]map[string]Strategy
+	defaults   []string
+}
+
+// NewRegistry returns a registry with MomentumStrategy registered and enabled by default
+func NewRegistry() *Registry {
+	r := &Registry{
+		strategies: make(map[string]Strategy),
+		factories:  make(map[string]Factory),
+		enabled:    make(map[string][]string),
+		tuned:      make(map[string]map[string]Strategy),
+	}
+	r.RegisterFactory(MomentumStrategyName, NewMomentumStrategyWithOptions)
+	r.defaults = []string{MomentumStrategyName}
+	return r
+}
+
+// Register adds s with its current settings, replacing any strategy or factory of the same name
+func (r *Registry) Register(s Strategy) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.strategies[s.Name()] = s
+	delete(r.factories, s.Name())
+}
+
+// RegisterFactory adds a strategy that can be tuned per symbol with Configure.
+// The untuned instance is built from zero Options.
+func (r *Registry) RegisterFactory(name string, factory Factory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.strategies[name] = factory(Options{})
+	r.factories[name] = factory
+}
+
+// Names lists every registered strategy
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.strategies))
+	for name := range r.strategies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetDefault sets the strategies used for symbols without their own selection
+func (r *Registry) SetDefault(names ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.validate(names); err != nil {
+		return err
+	}
+	r.defaults = names
+	return nil
+}
+
+// Enable selects the strategies evaluated for symbol, replacing any previous selection
+func (r *Registry) Enable(symbol string, names ...string) error {
+	return r.Configure(symbol, Options{}, names...)
+}
+
+// Configure selects the strategies evaluated for symbol, tuned with options where the
+// strategy has a factory. No names keeps the default strategies.
+func (r *Registry) Configure(symbol string, options Options, names ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.validate(names); err != nil {
+		return err
+	}
+
+	if len(names) > 0 {
+		r.enabled[symbol] = names
+	} else {
+		delete(r.enabled, symbol)
+	}
+
+	delete(r.tuned, symbol)
+	if options.isZero() {
+		return nil
+	}
+	tuned := make(map[string]Strategy)
+	for name, factory := range r.factories {
+		tuned[name] = factory(options)
+	}
+	r.tuned[symbol] = tuned
+	return nil
+}
+
+// Validate reports whether every name is a registered strategy
+func (r *Registry) Validate(names []string) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.validate(names)
+}
+
+// Reset makes symbol fall back to the default strategies
+func (r *Registry) Reset(symbol string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.enabled, symbol)
+	delete(r.tuned, symbol)
+}
+
+func (r *Registry) ForSymbol(symbol string) []Strategy {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names, ok := r.enabled[symbol]
+	if !ok {
+		names = r.defaults
+	}
+
+	strategies := make([]Strategy, 0, len(names))
+	for _, name := range names {
+		if tuned, ok := r.tuned[symbol][name]; ok {
+			strategies = append(strategies, tuned)
+			continue
+		}
+		strategies = append(strategies, r.strategies[name])
+	}
+	return strategies
+}
+
+func (r *Registry) validate(names []string) error {
+	for _, name := range names {
+		if _, ok := r.strategies[name]; !ok {
+			return fmt.Errorf("unknown strategy %q", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package strategy
+
+import (
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"time"
+)
+
+type Side string
+
+const (
+	// SideBuy is emitted on a strong uptrend
+	SideBuy Side = "BUY"
+	// SideDip is emitted on a strong downtrend, i.e. buy the dip
+	SideDip Side = "DIP"
+)
+
+type Signal struct {
+	Symbol    string
+	Strategy  string
+	Side      Side
+	Strength  float64 // 0 to 1, how convincing the move is
+	Reason    string
+	Price     float64
+	ChangePct float64
+	// WindowStart and WindowEnd are the timestamps of the first and last price evaluated
+	WindowStart time.Time
+	WindowEnd   time.Time
+}
+
+// Strategy evaluates a symbol's price window, ordered oldest first, and returns any signals it produces
+type Strategy interface {
+	Name() string
+	Evaluate(symbol string, window []models.TickerPrice) []Signal
+}
@@ -0,0 +1,317 @@
+package telegram_bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/khorzhenwin/gold-digger/internal/config"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"github.com/khorzhenwin/gold-digger/internal/signals"
+	"github.com/khorzhenwin/gold-digger/internal/ticker-price"
+	"github.com/khorzhenwin/gold-digger/internal/watchlist"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// retryDelay is the pause after a failed long poll before polling again
+	retryDelay = 5 * time.Second
+	// commandTimeout bounds a single command, /price may wait on the provider's rate limiter
+	commandTimeout = 20 * time.Second
+	// defaultMute is used when /mute is given no duration
+	defaultMute      = time.Hour
+	maxMute          = 30 * 24 * time.Hour
+	signalsListLimit = 10
+	signalsLookback  = 7 * 24 * time.Hour
+)
+
+var symbolPattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9.\-:/]{0,19}$`)
+
+const helpText = `Gold Digger commands:
+/add SYMBOL [note] - watch a symbol
+/remove SYMBOL - stop watching a symbol
+/list - show the watchlist
+/price SYMBOL - latest price
+/mute SYMBOL [2h|1d] - silence signals, 1h by default
+/unmute SYMBOL - resume signals, lifting any mute
+/signals [SYMBOL] - signals of the last 7 days`
+
+// Bot answers watchlist commands sent to the Telegram bot. Only the configured chats may run
+// commands, other chats are told their chat ID so it can be allowed.
+type Bot struct {
+	client             Client
+	watchlistService   *watchlist.Service
+	tickerPriceService *ticker_price.Service
+	signalService      *signals.Service
+	allowed            map[int64]bool
+	pollTimeout        time.Duration
+	now                func() time.Time
+}
+
+func NewBot(client Client, botConfig *config.TelegramBotConfig, watchlistService *watchlist.Service, tickerPriceService *ticker_price.Service, signalService *signals.Service) *Bot {
+	allowed := make(map[int64]bool, len(botConfig.AllowedChatIDs))
+	for _, id := range botConfig.AllowedChatIDs {
+		allowed[id] = true
+	}
+	return &Bot{
+		client:             client,
+		watchlistService:   watchlistService,
+		tickerPriceService: tickerPriceService,
+		signalService:      signalService,
+		allowed:            allowed,
+		pollTimeout:        botConfig.PollTimeout,
+		now:                time.Now,
+	}
+}
+
+// Start long polls for updates until ctx is cancelled, answering each command in order
+func (b *Bot) Start(ctx context.Context) error {
+	log.Println("🤖 Telegram bot started")
+
+	var offset int64
+	for {
+		updates, err := b.client.GetUpdates(ctx, offset, b.pollTimeout)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			log.Printf("⚠️ Failed to poll Telegram updates: %v", err)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(retryDelay):
+			}
+			continue
+		}
+
+		for _, update := range updates {
+			offset = update.UpdateID + 1
+			if update.Message == nil || !strings.HasPrefix(update.Message.Text, "/") {
+				continue
+			}
+			b.handle(ctx, update.Message)
+		}
+	}
+}
+
+func (b *Bot) handle(ctx context.Context, message *Message) {
+	chatID := message.Chat.ID
+	if !b.allowed[chatID] {
+		log.Printf("⛔ Refused Telegram command from chat %d", chatID)
+		b.reply(ctx, chatID, fmt.Sprintf("⛔ This chat is not authorised. Add chat ID %d to TELEGRAM_ALLOWED_CHAT_IDS to use the bot.", chatID))
+		return
+	}
+
+	commandCtx, cancel := context.WithTimeout(ctx, commandTimeout)
+	defer cancel()
+
+	command, args := parseCommand(message.Text)
+	log.Printf("🤖 Telegram command %s %v from chat %d", command, args, chatID)
+
+	var reply string
+	switch command {
+	case "/start", "/help":
+		reply = helpText
+	case "/add":
+		reply = b.add(args)
+	case "/remove":
+		reply = b.remove(args)
+	case "/list":
+		reply = b.list()
+	case "/price":
+		reply = b.price(commandCtx, args)
+	case "/mute":
+		reply = b.mute(args)
+	case "/unmute":
+		reply = b.unmute(args)
+	case "/signals":
+		reply = b.signals(args)
+	default:
+		reply = "Unknown command " + command + "\n\n" + helpText
+	}
+	b.reply(ctx, chatID, reply)
+}
+
+func (b *Bot) reply(ctx context.Context, chatID int64, text string) {
+	// replies still go out while shutting down
+	sendCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), commandTimeout)
+	defer cancel()
+	if err := b.client.SendMessage(sendCtx, chatID, text); err != nil {
+		log.Printf("⚠️ Failed to reply to Telegram chat %d: %v", chatID, err)
+	}
+}
+
+// parseCommand splits "/add@GoldDiggerBot pltr note" into "/add" and its arguments
+func parseCommand(text string) (string, []string) {
+	fields := strings.Fields(text)
+	command := strings.ToLower(fields[0])
+	if at := strings.Index(command, "@"); at >= 0 {
+		command = command[:at]
+	}
+	return command, fields[1:]
+}
+
+func parseSymbol(args []string) (string, error) {
+	if len(args) == 0 {
+		return "", errors.New("a symbol is required")
+	}
+	symbol := strings.ToUpper(args[0])
+	if !symbolPattern.MatchString(symbol) {
+		return "", fmt.Errorf("%q is not a valid symbol", args[0])
+	}
+	return symbol, nil
+}
+
+func (b *Bot) add(args []string) string {
+	symbol, err := parseSymbol(args)
+	if err != nil {
+		return "⚠️ " + err.Error() + ", e.g. /add PLTR long term hold"
+	}
+
+	existing, err := b.watchlistService.FindBySymbol(symbol)
+	if err != nil {
+		log.Printf("❌ Failed to look up %s: %v", symbol, err)
+		return "❌ Failed to read the watchlist"
+	}
+	if existing != nil {
+		return "ℹ️ " + symbol + " is already on the watchlist"
+	}
+
+	ticker := &models.Ticker{Symbol: symbol, Notes: strings.Join(args[1:], " ")}
+	if err := b.watchlistService.CreateTicker(ticker); err != nil {
+		log.Printf("❌ Failed to add %s: %v", symbol, err)
+		return "❌ Failed to add " + symbol
+	}
+	return "✅ Added " + symbol + " to the watchlist"
+}
+
+func (b *Bot) remove(args []string) string {
+	symbol, err := parseSymbol(args)
+	if err != nil {
+		return "⚠️ " + err.Error() + ", e.g. /remove PLTR"
+	}
+
+	ticker, err := b.watchlistService.FindBySymbol(symbol)
+	if err != nil {
+		log.Printf("❌ Failed to look up %s: %v", symbol, err)
+		return "❌ Failed to read the watchlist"
+	}
+	if ticker == nil {
+		return "ℹ️ " + symbol + " is not on the watchlist"
+	}
+	if err := b.watchlistService.DeleteTicker(ticker.ID); err != nil {
+		log.Printf("❌ Failed to remove %s: %v", symbol, err)
+		return "❌ Failed to remove " + symbol
+	}
+	return "🗑️ Removed " + symbol + " from the watchlist"
+}
+
+func (b *Bot) list() string {
+	tickers, err := b.watchlistService.FindAll()
+	if err != nil {
+		log.Printf("❌ Failed to list the watchlist: %v", err)
+		return "❌ Failed to read the watchlist"
+	}
+	if len(tickers) == 0 {
+		return "The watchlist is empty, add a symbol with /add SYMBOL"
+	}
+
+	var lines []string
+	for _, t := range tickers {
+		line := t.Symbol
+		if t.Notes != "" {
+			line += " - " + t.Notes
+		}
+		switch {
+		case t.Alert.Muted:
+			line += " 🔕"
+		case t.Alert.MutedUntil != nil && t.Alert.MutedUntil.After(b.now()):
+			line += " 🔕 until " + t.Alert.MutedUntil.Format("Jan 2 15:04 MST")
+		}
+		lines = append(lines, line)
+	}
+	return fmt.Sprintf("👀 Watching %d symbols:\n%s", len(tickers), strings.Join(lines, "\n"))
+}
+
+func (b *Bot) price(ctx context.Context, args []string) string {
+	symbol, err := parseSymbol(args)
+	if err != nil {
+		return "⚠️ " + err.Error() + ", e.g. /price SOUN"
+	}
+
+	quote := b.tickerPriceService.GetLatestPrice(ctx, symbol)
+	if quote == nil {
+		return "⚠️ No price available for " + symbol
+	}
+	return fmt.Sprintf("💵 %s %.2f\nas of %s (%s)", symbol, quote.Price, quote.AsOf.Format("Jan 2 15:04 MST"), quote.Source)
+}
+
+func (b *Bot) mute(args []string) string {
+	symbol, err := parseSymbol(args)
+	if err != nil {
+		return "⚠️ " + err.Error() + ", e.g. /mute TEM 2h"
+	}
+
+	duration := defaultMute
+	if len(args) > 1 {
+		duration, err = ticker_price.ParseInterval(args[1])
+		if err != nil || duration <= 0 || duration > maxMute {
+			return fmt.Sprintf("⚠️ Invalid duration %q, use e.g. 30m, 2h or 1d up to 30d", args[1])
+		}
+	}
+
+	until := b.now().Add(duration)
+	if _, err := b.watchlistService.MuteUntil(symbol, until); err != nil {
+		return b.muteError(symbol, err)
+	}
+	return fmt.Sprintf("🔕 Muted %s signals until %s", symbol, until.Format("Jan 2 15:04 MST"))
+}
+
+func (b *Bot) unmute(args []string) string {
+	symbol, err := parseSymbol(args)
+	if err != nil {
+		return "⚠️ " + err.Error() + ", e.g. /unmute TEM"
+	}
+	if _, err := b.watchlistService.MuteUntil(symbol, time.Time{}); err != nil {
+		return b.muteError(symbol, err)
+	}
+	return "🔔 Unmuted " + symbol
+}
+
+func (b *Bot) muteError(symbol string, err error) string {
+	if errors.Is(err, watchlist.ErrNotOnWatchlist) {
+		return "ℹ️ " + symbol + " is not on the watchlist"
+	}
+	log.Printf("❌ Failed to mute %s: %v", symbol, err)
+	return "❌ Failed to update " + symbol
+}
+
+func (b *Bot) signals(args []string) string {
+	now := b.now()
+	filter := signals.Filter{From: now.Add(-signalsLookback), To: now, Limit: signalsListLimit}
+	if len(args) > 0 {
+		symbol, err := parseSymbol(args)
+		if err != nil {
+			return "⚠️ " + err.Error() + ", e.g. /signals PLTR"
+		}
+		filter.Symbol = symbol
+	}
+
+	found, err := b.signalService.Find(filter)
+	if err != nil {
+		log.Printf("❌ Failed to list signals: %v", err)
+		return "❌ Failed to read signals"
+	}
+	if len(found) == 0 {
+		return "No signals in the last 7 days"
+	}
+
+	lines := make([]string, 0, len(found))
+	for _, s := range found {
+		lines = append(lines, fmt.Sprintf("%s %s %s %.2f (%+.2f%%) [%s]",
+			s.CreatedAt.Format("Jan 2 15:04"), s.Direction, s.Symbol, s.Price, s.ChangePct, s.Strategy))
+	}
+	return "📊 Recent signals:\n" + strings.Join(lines, "\n")
+}
@@ -0,0 +1,214 @@
+package telegram_bot
+
+import (
+	"context"
+	"errors"
+	"github.com/khorzhenwin/gold-digger/internal/config"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"github.com/khorzhenwin/gold-digger/internal/watchlist"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const allowedChat int64 = 42
+
+// memoryStorage is an in-memory watchlist.Storage
+type memoryStorage struct {
+	mu      sync.Mutex
+	nextID  uint
+	tickers []models.Ticker
+}
+
+func (m *memoryStorage) Create(ticker *models.Ticker) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nextID++
+	ticker.ID = m.nextID
+	m.tickers = append(m.tickers, *ticker)
+	return nil
+}
+
+func (m *memoryStorage) GetAll() ([]models.Ticker, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]models.Ticker(nil), m.tickers...), nil
+}
+
+func (m *memoryStorage) GetByID(id uint) (*models.Ticker, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, ticker := range m.tickers {
+		if ticker.ID == id {
+			return &ticker, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *memoryStorage) GetBySymbol(symbol string) (*models.Ticker, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, ticker := range m.tickers {
+		if ticker.Symbol == symbol {
+			return &ticker, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *memoryStorage) Update(id uint, updated models.Ticker) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range m.tickers {
+		if m.tickers[i].ID == id {
+			updated.ID = id
+			m.tickers[i] = updated
+			return nil
+		}
+	}
+	return errors.New("no record found to update")
+}
+
+func (m *memoryStorage) Delete(id uint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range m.tickers {
+		if m.tickers[i].ID == id {
+			m.tickers = append(m.tickers[:i], m.tickers[i+1:]...)
+			return nil
+		}
+	}
+	return errors.New("no record found to delete")
+}
+
+// startBot runs a bot on a LocalClient that only allows allowedChat
+func startBot(t *testing.T, store *memoryStorage, now time.Time) *LocalClient {
+	t.Helper()
+
+	client := NewLocalClient()
+	botConfig := &config.TelegramBotConfig{Enabled: true, AllowedChatIDs: []int64{allowedChat}, PollTimeout: time.Second}
+	bot := NewBot(client, botConfig, watchlist.NewService(store, nil), nil, nil)
+	bot.now = func() time.Time { return now }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = bot.Start(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	return client
+}
+
+func send(t *testing.T, client *LocalClient, chatID int64, text string) string {
+	t.Helper()
+
+	client.Push(chatID, text)
+	select {
+	case reply := <-client.Replies():
+		if reply.ChatID != chatID {
+			t.Fatalf("%s: reply went to chat %d, want %d", text, reply.ChatID, chatID)
+		}
+		return reply.Text
+	case <-time.After(5 * time.Second):
+		t.Fatalf("%s: no reply", text)
+		return ""
+	}
+}
+
+func TestBotCommands(t *testing.T) {
+	now := time.Date(2026, time.March, 2, 15, 0, 0, 0, time.UTC)
+	client := startBot(t, &memoryStorage{}, now)
+
+	steps := []struct {
+		chatID int64
+		text   string
+		want   string
+	}{
+		{chatID: 7, text: "/list", want: "Add chat ID 7 to TELEGRAM_ALLOWED_CHAT_IDS"},
+		{chatID: allowedChat, text: "/start", want: "Gold Digger commands:"},
+		{chatID: allowedChat, text: "/list", want: "The watchlist is empty"},
+		{chatID: allowedChat, text: "/add", want: "a symbol is required"},
+		{chatID: allowedChat, text: "/add $$$", want: "is not a valid symbol"},
+		{chatID: allowedChat, text: "/add@GoldDiggerBot pltr long term hold", want: "✅ Added PLTR"},
+		{chatID: allowedChat, text: "/add PLTR", want: "PLTR is already on the watchlist"},
+		{chatID: allowedChat, text: "/add d05.si", want: "✅ Added D05.SI"},
+		{chatID: allowedChat, text: "/list", want: "👀 Watching 2 symbols:\nPLTR - long term hold\nD05.SI"},
+		{chatID: allowedChat, text: "/mute PLTR 2h", want: "🔕 Muted PLTR signals until Mar 2 17:00 UTC"},
+		{chatID: allowedChat, text: "/list", want: "PLTR - long term hold 🔕 until Mar 2 17:00 UTC"},
+		{chatID: allowedChat, text: "/mute PLTR 31d", want: "Invalid duration \"31d\""},
+		{chatID: allowedChat, text: "/mute TEM", want: "TEM is not on the watchlist"},
+		{chatID: allowedChat, text: "/unmute PLTR", want: "🔔 Unmuted PLTR"},
+		{chatID: allowedChat, text: "/list", want: "PLTR - long term hold\nD05.SI"},
+		{chatID: allowedChat, text: "/remove pltr", want: "🗑️ Removed PLTR"},
+		{chatID: allowedChat, text: "/remove PLTR", want: "PLTR is not on the watchlist"},
+		{chatID: allowedChat, text: "/dance", want: "Unknown command /dance"},
+	}
+
+	for _, step := range steps {
+		if got := send(t, client, step.chatID, step.text); !strings.Contains(got, step.want) {
+			t.Errorf("%s: got %q, want it to contain %q", step.text, got, step.want)
+		}
+	}
+}
+
+func TestBotAddInfersExchange(t *testing.T) {
+	store := &memoryStorage{}
+	client := startBot(t, store, time.Now())
+
+	send(t, client, allowedChat, "/add 0700.HK Tencent")
+	ticker, _ := store.GetBySymbol("0700.HK")
+	if ticker == nil {
+		t.Fatal("0700.HK was not added")
+	}
+	if ticker.Exchange != "XHKG" || ticker.Notes != "Tencent" {
+		t.Errorf("got exchange %q and notes %q, want XHKG and Tencent", ticker.Exchange, ticker.Notes)
+	}
+}
+
+func TestBotUnmuteLiftsPermanentMute(t *testing.T) {
+	now := time.Date(2026, time.March, 2, 15, 0, 0, 0, time.UTC)
+	until := now.Add(time.Hour)
+	store := &memoryStorage{}
+	if err := store.Create(&models.Ticker{Symbol: "TEM", Alert: models.AlertConfig{Muted: true, MutedUntil: &until}}); err != nil {
+		t.Fatal(err)
+	}
+	client := startBot(t, store, now)
+
+	if got := send(t, client, allowedChat, "/list"); !strings.HasSuffix(got, "TEM 🔕") {
+		t.Errorf("/list: got %q, want TEM shown as muted", got)
+	}
+	send(t, client, allowedChat, "/unmute TEM")
+
+	ticker, _ := store.GetBySymbol("TEM")
+	if ticker.Alert.Muted || ticker.Alert.MutedUntil != nil {
+		t.Errorf("got muted %t until %v, want TEM unmuted", ticker.Alert.Muted, ticker.Alert.MutedUntil)
+	}
+}
+
+func TestUpdateKeepsTimedMute(t *testing.T) {
+	until := time.Date(2026, time.March, 2, 17, 0, 0, 0, time.UTC)
+	store := &memoryStorage{}
+	ticker := &models.Ticker{Symbol: "SOUN", Alert: models.AlertConfig{MutedUntil: &until}}
+	if err := store.Create(ticker); err != nil {
+		t.Fatal(err)
+	}
+
+	service := watchlist.NewService(store, nil)
+	if err := service.UpdateTicker(ticker.ID, models.Ticker{Symbol: "SOUN", Notes: "SoundHound AI"}); err != nil {
+		t.Fatal(err)
+	}
+
+	updated, _ := store.GetByID(ticker.ID)
+	if updated.Alert.MutedUntil == nil || !updated.Alert.MutedUntil.Equal(until) {
+		t.Errorf("got muted until %v, want %v", updated.Alert.MutedUntil, until)
+	}
+	if updated.Notes != "SoundHound AI" {
+		t.Errorf("got notes %q, want the update applied", updated.Notes)
+	}
+}
@@ -0,0 +1,190 @@
+package telegram_bot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type Chat struct {
+	ID int64 `json:"id"`
+}
+
+type Message struct {
+	MessageID int64  `json:"message_id"`
+	Chat      Chat   `json:"chat"`
+	Text      string `json:"text"`
+}
+
+// Update is the part of a Telegram update the bot reads, anything but text messages is ignored
+type Update struct {
+	UpdateID int64    `json:"update_id"`
+	Message  *Message `json:"message,omitempty"`
+}
+
+// Client receives updates and sends replies. APIClient talks to Telegram, LocalClient stands in
+// for it when running without a bot token or in tests.
+type Client interface {
+	// GetUpdates returns updates from offset on, waiting up to timeout for the first one
+	GetUpdates(ctx context.Context, offset int64, timeout time.Duration) ([]Update, error)
+	SendMessage(ctx context.Context, chatID int64, text string) error
+}
+
+// APIClient long polls the Telegram Bot API
+type APIClient struct {
+	baseURL string
+	client  *http.Client
+}
+
+func NewAPIClient(botToken string) *APIClient {
+	return &APIClient{
+		baseURL: "https://api.telegram.org/bot" + botToken,
+		// per request timeouts come from ctx, long polls outlive a fixed client timeout
+		client: &http.Client{},
+	}
+}
+
+type apiResponse[T any] struct {
+	OK          bool   `json:"ok"`
+	Description string `json:"description"`
+	Result      T      `json:"result"`
+}
+
+func (c *APIClient) GetUpdates(ctx context.Context, offset int64, timeout time.Duration) ([]Update, error) {
+	// allow the long poll to run its course before giving up on the request
+	ctx, cancel := context.WithTimeout(ctx, timeout+10*time.Second)
+	defer cancel()
+
+	var updates []Update
+	err := c.call(ctx, "getUpdates", map[string]interface{}{
+		"offset":          offset,
+		"timeout":         int(timeout.Seconds()),
+		"allowed_updates": []string{"message"},
+	}, &updates)
+	return updates, err
+}
+
+func (c *APIClient) SendMessage(ctx context.Context, chatID int64, text string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.call(ctx, "sendMessage", map[string]interface{}{
+		"chat_id": chatID,
+		"text":    text,
+	}, nil)
+}
+
+func (c *APIClient) call(ctx context.Context, method string, payload interface{}, result interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/"+method, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build %s request: %w", method, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s failed: %w", method, err)
+	}
+	defer resp.Body.Close()
+
+	var decoded apiResponse[json.RawMessage]
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return fmt.Errorf("%s responded with status %d: %w", method, resp.StatusCode, err)
+	}
+	if !decoded.OK {
+		return fmt.Errorf("%s responded with status %d: %s", method, resp.StatusCode, decoded.Description)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(decoded.Result, result)
+}
+
+// LocalClient is an in-memory Client. Push queues a message as if a chat sent it and the
+// bot's replies are read from Replies.
+type LocalClient struct {
+	mu      sync.Mutex
+	nextID  int64
+	pending []Update
+	arrived chan struct{}
+	replies chan Reply
+}
+
+// Reply is a message the bot sent through a LocalClient
+type Reply struct {
+	ChatID int64
+	Text   string
+}
+
+func NewLocalClient() *LocalClient {
+	return &LocalClient{
+		nextID:  1,
+		arrived: make(chan struct{}, 1),
+		replies: make(chan Reply, 64),
+	}
+}
+
+// Push queues text as a message from chatID
+func (c *LocalClient) Push(chatID int64, text string) {
+	c.mu.Lock()
+	c.pending = append(c.pending, Update{
+		UpdateID: c.nextID,
+		Message:  &Message{MessageID: c.nextID, Chat: Chat{ID: chatID}, Text: text},
+	})
+	c.nextID++
+	c.mu.Unlock()
+
+	select {
+	case c.arrived <- struct{}{}:
+	default:
+	}
+}
+
+// Replies yields every message the bot sent, it blocks the bot once 64 are left unread
+func (c *LocalClient) Replies() <-chan Reply {
+	return c.replies
+}
+
+func (c *LocalClient) GetUpdates(ctx context.Context, offset int64, timeout time.Duration) ([]Update, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		c.mu.Lock()
+		// like Telegram, an offset confirms every update before it
+		for len(c.pending) > 0 && c.pending[0].UpdateID < offset {
+			c.pending = c.pending[1:]
+		}
+		if len(c.pending) > 0 {
+			updates := append([]Update(nil), c.pending...)
+			c.mu.Unlock()
+			return updates, nil
+		}
+		c.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+			return nil, nil
+		case <-c.arrived:
+		}
+	}
+}
+
+func (c *LocalClient) SendMessage(ctx context.Context, chatID int64, text string) error {
+	select {
+	case c.replies <- Reply{ChatID: chatID, Text: text}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
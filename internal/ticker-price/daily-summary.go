@@ -0,0 +1,139 @@
+package ticker_price
+
+import (
+	"context"
+	"fmt"
+	"github.com/khorzhenwin/gold-digger/internal/calendar"
+	"github.com/khorzhenwin/gold-digger/internal/config"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"github.com/khorzhenwin/gold-digger/internal/notification"
+	"github.com/khorzhenwin/gold-digger/internal/watchlist"
+	"log"
+	"sort"
+	"strings"
+	"time"
+)
+
+// StartDailySummary sends a summary of the day's moves across the watchlist at policy.SummaryAt,
+// computed from the prices stored since midnight in policy.Location. Weekends and days none of
+// the watched exchanges trade are skipped, so are days without stored prices.
+func StartDailySummary(ctx context.Context, notifier models.Notifier, tickerPriceRepository *Repository, watchlistService *watchlist.Service, policy *config.NotificationPolicyConfig) error {
+	log.Printf("📅 Daily summary scheduled at %02d:%02d %s", policy.SummaryAt/60, policy.SummaryAt%60, policy.Location)
+
+	for {
+		next := nextSummaryAt(time.Now(), policy)
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		sendDailySummary(ctx, notifier, tickerPriceRepository, watchlistService, next)
+	}
+}
+
+func nextSummaryAt(now time.Time, policy *config.NotificationPolicyConfig) time.Time {
+	local := now.In(policy.Location)
+	next := time.Date(local.Year(), local.Month(), local.Day(), policy.SummaryAt/60, policy.SummaryAt%60, 0, 0, policy.Location)
+	if !next.After(local) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+func sendDailySummary(ctx context.Context, notifier models.Notifier, tickerPriceRepository *Repository, watchlistService *watchlist.Service, at time.Time) {
+	tickers, err := watchlistService.FindAll()
+	if err != nil {
+		log.Printf("⚠️ Failed to load the watchlist for the daily summary: %v", err)
+		return
+	}
+	symbols := make([]string, 0, len(tickers))
+	notes := make(map[string]string, len(tickers))
+	for _, t := range tickers {
+		symbols = append(symbols, t.Symbol)
+		notes[t.Symbol] = t.Notes
+	}
+
+	if !isTradingDay(tickers, at) {
+		log.Printf("📅 No watched exchange trades on %s, skipping the daily summary", at.Format("Mon Jan 2"))
+		return
+	}
+
+	dayStart := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, at.Location())
+	moves, err := tickerPriceRepository.GetMoves(symbols, dayStart, at)
+	if err != nil {
+		log.Printf("⚠️ Failed to compute the daily summary: %v", err)
+		return
+	}
+	if len(moves) == 0 {
+		log.Println("📅 No prices stored today, skipping the daily summary")
+		return
+	}
+
+	movers := make([]notification.Mover, 0, len(moves))
+	for _, move := range moves {
+		mover := notification.Mover{
+			Symbol: move.Symbol,
+			Notes:  notes[move.Symbol],
+			Open:   move.Open,
+			High:   move.High,
+			Low:    move.Low,
+			Close:  move.Close,
+		}
+		if move.Open != 0 {
+			mover.ChangePct = (move.Close - move.Open) / move.Open * 100
+		}
+		movers = append(movers, mover)
+	}
+	// biggest gainers first, biggest losers last
+	sort.SliceStable(movers, func(i, j int) bool {
+		return movers[i].ChangePct > movers[j].ChangePct
+	})
+
+	data := notification.MessageData{Date: dayStart, Movers: movers}
+	sendCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), notificationTimeout)
+	defer cancel()
+	if err := notification.SendTemplate(sendCtx, notifier, notification.KindSummary, data, formatSummaryMessage(data)); err != nil {
+		log.Printf("⚠️ Failed to send the daily summary: %v", err)
+		return
+	}
+	log.Printf("📅 Sent the daily summary of %d symbols", len(movers))
+}
+
+// isTradingDay reports whether the local date of at is a weekday on which one of the exchanges of
+// tickers holds a session. Always open exchanges like crypto do not count, a watchlist of only
+// those follows the NYSE calendar.
+func isTradingDay(tickers []models.Ticker, at time.Time) bool {
+	if at.Weekday() == time.Saturday || at.Weekday() == time.Sunday {
+		return false
+	}
+
+	exchanges := make(map[*calendar.Exchange]bool)
+	for _, t := range tickers {
+		if exchange := exchangeOf(t.Exchange); !exchange.AlwaysOpen() {
+			exchanges[exchange] = true
+		}
+	}
+	if len(exchanges) == 0 {
+		exchanges[calendar.NYSE] = true
+	}
+
+	for exchange := range exchanges {
+		// the summary's date, taken at noon on the exchange so time zones cannot shift it a day
+		noon := time.Date(at.Year(), at.Month(), at.Day(), 12, 0, 0, 0, exchange.Location())
+		if _, _, ok := exchange.Session(noon); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func formatSummaryMessage(data notification.MessageData) string {
+	lines := []string{fmt.Sprintf("📅 Watchlist summary for %s", data.Date.Format("Mon Jan 2"))}
+	for _, mover := range data.Movers {
+		lines = append(lines, fmt.Sprintf("%s %.2f (%+.2f%%) low %.2f high %.2f", mover.Symbol, mover.Close, mover.ChangePct, mover.Low, mover.High))
+	}
+	return strings.Join(lines, "\n")
+}
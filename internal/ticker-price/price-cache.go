@@ -0,0 +1,33 @@
+package ticker_price
+
+import (
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"sync"
+)
+
+// PriceCache holds the most recent price seen per symbol
+type PriceCache struct {
+	mu     sync.RWMutex
+	prices map[string]models.TickerPrice
+}
+
+func NewPriceCache() *PriceCache {
+	return &PriceCache{prices: make(map[string]models.TickerPrice)}
+}
+
+func (c *PriceCache) Get(symbol string) (models.TickerPrice, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	price, ok := c.prices[symbol]
+	return price, ok
+}
+
+// Put keeps the newer of the cached and the given price
+func (c *PriceCache) Put(price models.TickerPrice) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cached, ok := c.prices[price.Symbol]; ok && cached.Timestamp.After(price.Timestamp) {
+		return
+	}
+	c.prices[price.Symbol] = price
+}
@@ -0,0 +1,152 @@
+package ticker_price
+
+import (
+	"github.com/khorzhenwin/gold-digger/internal/calendar"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"github.com/khorzhenwin/gold-digger/internal/strategy"
+	"time"
+)
+
+const signalCooldown = time.Hour
+
+// SymbolConfig overrides the engine's defaults for one symbol, zero fields keep the defaults
+type SymbolConfig struct {
+	WindowSize int
+	Cooldown   time.Duration
+	// Muted symbols keep their window up to date but never signal, MutedUntil does so until it passes
+	Muted      bool
+	MutedUntil time.Time
+	// Notes are the watchlist item's notes, shown in its notifications
+	Notes string
+	// ExtendedHours lists the strategies evaluating ticks polled outside the regular session too,
+	// the others only see ticks from the regular session
+	ExtendedHours map[string]bool
+}
+
+// SignalEngine keeps a rolling price window per symbol and runs the enabled strategies over it.
+// It is not safe for concurrent use, callers serialise access.
+type SignalEngine struct {
+	registry   *strategy.Registry
+	now        func() time.Time
+	windows    map[string][]models.TickerPrice
+	lastSignal map[string]time.Time
+	configs    map[string]SymbolConfig
+}
+
+// NewSignalEngine uses now as its clock, which lets a replay drive the cooldown with simulated time
+func NewSignalEngine(registry *strategy.Registry, now func() time.Time) *SignalEngine {
+	return &SignalEngine{
+		registry:   registry,
+		now:        now,
+		windows:    make(map[string][]models.TickerPrice),
+		lastSignal: make(map[string]time.Time),
+		configs:    make(map[string]SymbolConfig),
+	}
+}
+
+// SetConfigs replaces every per-symbol override, symbols left out go back to the defaults
+func (e *SignalEngine) SetConfigs(configs map[string]SymbolConfig) {
+	e.configs = configs
+	for symbol, window := range e.windows {
+		if size := e.windowSize(symbol); len(window) > size {
+			e.windows[symbol] = window[len(window)-size:]
+		}
+	}
+}
+
+func (e *SignalEngine) windowSize(symbol string) int {
+	if size := e.configs[symbol].WindowSize; size > 0 {
+		return size
+	}
+	return signalWindowSize
+}
+
+func (e *SignalEngine) cooldown(symbol string) time.Duration {
+	if cooldown := e.configs[symbol].Cooldown; cooldown > 0 {
+		return cooldown
+	}
+	return signalCooldown
+}
+
+// Seed replaces the window of symbol, prices ordered oldest first
+func (e *SignalEngine) Seed(symbol string, prices []models.TickerPrice) {
+	e.windows[symbol] = nil
+	for _, price := range prices {
+		e.Add(price)
+	}
+}
+
+func (e *SignalEngine) Add(price models.TickerPrice) {
+	window := append(e.windows[price.Symbol], price)
+	if size := e.windowSize(price.Symbol); len(window) > size {
+		window = window[len(window)-size:]
+	}
+	e.windows[price.Symbol] = window
+}
+
+// Window returns the prices of symbol currently evaluated, oldest first
+func (e *SignalEngine) Window(symbol string) []models.TickerPrice {
+	return append([]models.TickerPrice(nil), e.windows[symbol]...)
+}
+
+// Notes returns the notes configured for symbol
+func (e *SignalEngine) Notes(symbol string) string {
+	return e.configs[symbol].Notes
+}
+
+func (e *SignalEngine) SetLastSignal(symbol string, at time.Time) {
+	e.lastSignal[symbol] = at
+}
+
+func (e *SignalEngine) Symbols() []string {
+	symbols := make([]string, 0, len(e.windows))
+	for symbol := range e.windows {
+		symbols = append(symbols, symbol)
+	}
+	return symbols
+}
+
+// Evaluate runs the strategies enabled for symbol unless it is muted or still cooling down.
+// The cooldown only starts once the caller confirms delivery with MarkSignalled.
+func (e *SignalEngine) Evaluate(symbol string) []strategy.Signal {
+	if config := e.configs[symbol]; config.Muted || e.now().Before(config.MutedUntil) {
+		return nil
+	}
+	if last, ok := e.lastSignal[symbol]; ok && e.now().Sub(last) < e.cooldown(symbol) {
+		return nil
+	}
+
+	window := e.windows[symbol]
+	var regular []models.TickerPrice
+	var signals []strategy.Signal
+	for _, s := range e.registry.ForSymbol(symbol) {
+		if e.configs[symbol].ExtendedHours[s.Name()] {
+			signals = append(signals, s.Evaluate(symbol, window)...)
+			continue
+		}
+		if regular == nil {
+			regular = regularSession(window)
+		}
+		signals = append(signals, s.Evaluate(symbol, regular)...)
+	}
+	return signals
+}
+
+// regularSession keeps the prices quoted in the regular session, prices without a recorded
+// session count as regular
+func regularSession(window []models.TickerPrice) []models.TickerPrice {
+	regular := make([]models.TickerPrice, 0, len(window))
+	for _, price := range window {
+		if price.Session == "" || price.Session == string(calendar.SessionRegular) {
+			regular = append(regular, price)
+		}
+	}
+	return regular
+}
+
+// MarkSignalled starts the cooldown for symbol and returns the time it was recorded at
+func (e *SignalEngine) MarkSignalled(symbol string) time.Time {
+	now := e.now()
+	e.lastSignal[symbol] = now
+	return now
+}
@@ -0,0 +1,25 @@
+package ticker_price
+
+import (
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"gorm.io/gorm"
+)
+
+type SignalStateRepository struct {
+	db *gorm.DB
+}
+
+func NewSignalStateRepository(db *gorm.DB) *SignalStateRepository {
+	return &SignalStateRepository{db: db}
+}
+
+func (r *SignalStateRepository) GetAll() ([]models.SignalState, error) {
+	var states []models.SignalState
+	err := r.db.Find(&states).Error
+	return states, err
+}
+
+// Save upserts the state by symbol
+func (r *SignalStateRepository) Save(state models.SignalState) error {
+	return r.db.Save(&state).Error
+}
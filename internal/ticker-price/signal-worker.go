@@ -0,0 +1,240 @@
+package ticker_price
+
+import (
+	"context"
+	"fmt"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"github.com/khorzhenwin/gold-digger/internal/notification"
+	"github.com/khorzhenwin/gold-digger/internal/strategy"
+	"github.com/khorzhenwin/gold-digger/internal/watchlist"
+	"log"
+	"time"
+)
+
+const (
+	signalWindowSize = models.DefaultAlertWindowSize
+	// SignalEvaluationInterval is how often the worker runs the strategies over every window
+	SignalEvaluationInterval = 15 * time.Minute
+	// symbols without a stored price in this period are not warmed on startup
+	signalWarmupLookback = 30 * 24 * time.Hour
+)
+
+// notificationTimeout bounds a single send, sends are detached from shutdown so in-flight alerts finish
+const notificationTimeout = 15 * time.Second
+
+// SignalRecorder keeps an audit trail of every signal the worker fires and whether it was delivered
+type SignalRecorder interface {
+	Record(signal strategy.Signal) (*models.Signal, error)
+	MarkSent(id uint, sendErr error) error
+}
+
+// StartSignalWorker Refer to ADR-001
+// It blocks until input is closed, which happens once the poller has drained on shutdown.
+func StartSignalWorker(ctx context.Context, input <-chan models.TickerPrice, watchlistService *watchlist.Service, notifier models.Notifier, tickerPriceRepository *Repository, signalStateRepository *SignalStateRepository, signalRecorder SignalRecorder, registry *strategy.Registry) error {
+	engine := NewSignalEngine(registry, time.Now)
+
+	// alert configs size the windows, so they are applied before warming
+	configured := make(map[string]bool)
+	applyAlertConfigs(engine, registry, watchlistService, configured)
+	warmSignalEngine(engine, tickerPriceRepository, signalStateRepository)
+
+	evaluateSignal := func(symbol string) {
+		signals := engine.Evaluate(symbol)
+		if len(signals) == 0 {
+			return
+		}
+
+		// the cooldown starts once any signal got out, when none did the next run tries again
+		if deliverSignals(ctx, engine, notifier, signalRecorder, symbol, signals) == 0 {
+			return
+		}
+		now := engine.MarkSignalled(symbol)
+		if err := signalStateRepository.Save(models.SignalState{Symbol: symbol, LastSignalAt: now}); err != nil {
+			log.Printf("⚠️ Failed to persist signal state for %s: %v", symbol, err)
+		}
+	}
+
+	ticker := time.NewTicker(SignalEvaluationInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case msg, ok := <-input:
+			if !ok {
+				log.Println("🛑 Signal worker input closed, stopping")
+				return ctx.Err()
+			}
+			engine.Add(msg)
+
+		case <-ticker.C:
+			// no new evaluations once shutdown started, only the input is drained
+			if ctx.Err() != nil {
+				continue
+			}
+			// pick up alert configs edited through the watchlist APIs since the last run
+			applyAlertConfigs(engine, registry, watchlistService, configured)
+			for _, symbol := range engine.Symbols() {
+				evaluateSignal(symbol)
+			}
+		}
+	}
+}
+
+// deliverSignals records and sends every signal of one evaluation, a failed send is recorded
+// against its signal and does not hold back the others. It returns how many were sent.
+func deliverSignals(ctx context.Context, engine *SignalEngine, notifier models.Notifier, signalRecorder SignalRecorder, symbol string, signals []strategy.Signal) int {
+	delivered := 0
+	for _, signal := range signals {
+		message := formatSignalMessage(signal)
+		log.Println(message)
+
+		record, recordErr := signalRecorder.Record(signal)
+		if recordErr != nil {
+			log.Printf("⚠️ Failed to record signal for %s: %v", symbol, recordErr)
+		}
+
+		sendCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), notificationTimeout)
+		err := sendSignal(sendCtx, notifier, signal, engine.Window(symbol), engine.Notes(symbol), message)
+		cancel()
+		if record != nil {
+			if markErr := signalRecorder.MarkSent(record.ID, err); markErr != nil {
+				log.Printf("⚠️ Failed to update signal %d: %v", record.ID, markErr)
+			}
+		}
+		if err != nil {
+			log.Printf("⚠️ Failed to send %s notification for %s: %v", signal.Strategy, symbol, err)
+			continue
+		}
+		delivered++
+	}
+	return delivered
+}
+
+func formatSignalMessage(signal strategy.Signal) string {
+	switch signal.Side {
+	case strategy.SideBuy:
+		return fmt.Sprintf("🚀 BUY SIGNAL for %s - %s [%s]", signal.Symbol, signal.Reason, signal.Strategy)
+	case strategy.SideDip:
+		return fmt.Sprintf("🔻 BOGDANOFF HAS DOUMP IT. BUY THE DIP for %s - %s [%s]", signal.Symbol, signal.Reason, signal.Strategy)
+	default:
+		return fmt.Sprintf("📊 %s SIGNAL for %s - %s [%s]", signal.Side, signal.Symbol, signal.Reason, signal.Strategy)
+	}
+}
+
+// sendSignal renders the signal's message template, message is sent when that is not possible
+func sendSignal(ctx context.Context, notifier models.Notifier, signal strategy.Signal, window []models.TickerPrice, notes string, message string) error {
+	prices := make([]float64, 0, len(window))
+	for _, price := range window {
+		prices = append(prices, price.Price)
+	}
+	data := notification.MessageData{
+		Symbol:      signal.Symbol,
+		Notes:       notes,
+		Price:       signal.Price,
+		ChangePct:   signal.ChangePct,
+		Strategy:    signal.Strategy,
+		Side:        string(signal.Side),
+		Strength:    signal.Strength,
+		Reason:      signal.Reason,
+		Window:      prices,
+		WindowStart: signal.WindowStart,
+		WindowEnd:   signal.WindowEnd,
+	}
+	return notification.SendTemplate(ctx, notifier, signalKind(signal.Side), data, message)
+}
+
+func signalKind(side strategy.Side) string {
+	switch side {
+	case strategy.SideBuy:
+		return notification.KindBuy
+	case strategy.SideDip:
+		return notification.KindDip
+	default:
+		return notification.KindSignal
+	}
+}
+
+// applyAlertConfigs loads each watchlist item's alert config into the engine and registry.
+// configured tracks the symbols tuned on the previous run so removed items fall back to the defaults.
+func applyAlertConfigs(engine *SignalEngine, registry *strategy.Registry, watchlistService *watchlist.Service, configured map[string]bool) {
+	tickers, err := watchlistService.FindAll()
+	if err != nil {
+		log.Printf("⚠️ Failed to load alert configs, keeping the previous ones: %v", err)
+		return
+	}
+
+	configs := make(map[string]SymbolConfig, len(tickers))
+	seen := make(map[string]bool, len(tickers))
+	for _, t := range tickers {
+		alert := t.Alert
+		config := SymbolConfig{
+			WindowSize: alert.WindowSize,
+			Cooldown:   time.Duration(alert.CooldownMinutes) * time.Minute,
+			Muted:      alert.Muted,
+			Notes:      t.Notes,
+		}
+		if alert.MutedUntil != nil {
+			config.MutedUntil = *alert.MutedUntil
+		}
+		if len(alert.ExtendedHoursStrategies) > 0 {
+			config.ExtendedHours = make(map[string]bool, len(alert.ExtendedHoursStrategies))
+			for _, name := range alert.ExtendedHoursStrategies {
+				config.ExtendedHours[name] = true
+			}
+		}
+		configs[t.Symbol] = config
+
+		options := strategy.Options{MinChange: alert.MinChange, MinSteps: alert.MinSteps, WindowSize: alert.WindowSize}
+		if err := registry.Configure(t.Symbol, options, alert.Strategies...); err != nil {
+			log.Printf("⚠️ Invalid alert config for %s, using the defaults: %v", t.Symbol, err)
+			registry.Reset(t.Symbol)
+		}
+		seen[t.Symbol] = true
+	}
+
+	for symbol := range configured {
+		if !seen[symbol] {
+			registry.Reset(symbol)
+			delete(configured, symbol)
+		}
+	}
+	for symbol := range seen {
+		configured[symbol] = true
+	}
+	engine.SetConfigs(configs)
+}
+
+// warmSignalEngine rebuilds windows and cooldowns from TimescaleDB so a restart keeps its state
+func warmSignalEngine(engine *SignalEngine, tickerPriceRepository *Repository, signalStateRepository *SignalStateRepository) {
+	symbols, err := tickerPriceRepository.GetRecentSymbols(time.Now().Add(-signalWarmupLookback))
+	if err != nil {
+		log.Printf("⚠️ Failed to load symbols for signal warm-up: %v", err)
+	}
+
+	warmed := 0
+	for _, symbol := range symbols {
+		prices, err := tickerPriceRepository.GetLatest(symbol, engine.windowSize(symbol))
+		if err != nil {
+			log.Printf("⚠️ Failed to warm signal window for %s: %v", symbol, err)
+			continue
+		}
+
+		// GetLatest returns newest first, windows are kept oldest first
+		window := make([]models.TickerPrice, 0, len(prices))
+		for i := len(prices) - 1; i >= 0; i-- {
+			window = append(window, prices[i])
+		}
+		engine.Seed(symbol, window)
+		warmed++
+	}
+	log.Printf("🔥 Warmed signal windows for %d symbols", warmed)
+
+	states, err := signalStateRepository.GetAll()
+	if err != nil {
+		log.Printf("⚠️ Failed to load signal states: %v", err)
+		return
+	}
+	for _, state := range states {
+		engine.SetLastSignal(state.Symbol, state.LastSignalAt)
+	}
+}
@@ -0,0 +1,86 @@
+package ticker_price
+
+import (
+	"context"
+	"errors"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"github.com/khorzhenwin/gold-digger/internal/strategy"
+	"strings"
+	"testing"
+	"time"
+)
+
+// failingNotifier fails every message that mentions one of its strategies
+type failingNotifier struct {
+	failing []string
+	sent    []string
+}
+
+func (n *failingNotifier) Send(_ context.Context, message string) error {
+	for _, name := range n.failing {
+		if strings.Contains(message, "["+name+"]") {
+			return errors.New("telegram is down")
+		}
+	}
+	n.sent = append(n.sent, message)
+	return nil
+}
+
+// recorderStub keeps the delivery outcome of every recorded signal
+type recorderStub struct {
+	recorded []strategy.Signal
+	sendErrs map[uint]error
+}
+
+func (r *recorderStub) Record(signal strategy.Signal) (*models.Signal, error) {
+	r.recorded = append(r.recorded, signal)
+	return &models.Signal{ID: uint(len(r.recorded))}, nil
+}
+
+func (r *recorderStub) MarkSent(id uint, sendErr error) error {
+	r.sendErrs[id] = sendErr
+	return nil
+}
+
+func TestDeliverSignals(t *testing.T) {
+	signals := []strategy.Signal{
+		{Symbol: "PLTR", Strategy: "momentum", Side: strategy.SideBuy, Reason: "up 4%"},
+		{Symbol: "PLTR", Strategy: "rsi", Side: strategy.SideBuy, Reason: "oversold"},
+		{Symbol: "PLTR", Strategy: "breakout", Side: strategy.SideBuy, Reason: "new high"},
+	}
+	tests := []struct {
+		name          string
+		failing       []string
+		wantDelivered int
+	}{
+		{name: "all sent", wantDelivered: 3},
+		{name: "a failure in the middle", failing: []string{"rsi"}, wantDelivered: 2},
+		{name: "the first fails", failing: []string{"momentum"}, wantDelivered: 2},
+		{name: "all fail", failing: []string{"momentum", "rsi", "breakout"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifier := &failingNotifier{failing: tt.failing}
+			recorder := &recorderStub{sendErrs: make(map[uint]error)}
+			engine := NewSignalEngine(nil, time.Now)
+
+			delivered := deliverSignals(context.Background(), engine, notifier, recorder, "PLTR", signals)
+			if delivered != tt.wantDelivered || len(notifier.sent) != tt.wantDelivered {
+				t.Errorf("got %d delivered and %d sent, want %d", delivered, len(notifier.sent), tt.wantDelivered)
+			}
+			if len(recorder.recorded) != len(signals) || len(recorder.sendErrs) != len(signals) {
+				t.Fatalf("got %d recorded and %d marked, want every signal", len(recorder.recorded), len(recorder.sendErrs))
+			}
+			failed := 0
+			for _, err := range recorder.sendErrs {
+				if err != nil {
+					failed++
+				}
+			}
+			if failed != len(tt.failing) {
+				t.Errorf("got %d signals marked failed, want %d", failed, len(tt.failing))
+			}
+		})
+	}
+}
@@ -1,11 +1,16 @@
 package ticker_price
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"github.com/go-chi/chi/v5"
 	"net/http"
+	"time"
 )
 
+const providerRequestTimeout = 8 * time.Second
+
 type Handler struct {
 	Service Service
 }
@@ -13,18 +18,28 @@ type Handler struct {
 func RegisterRoutes(r chi.Router, service *Service) {
 	h := &Handler{Service: *service}
 
+	RegisterStreamRoutes(r, service)
 	r.Route("/ticker-price", func(r chi.Router) {
 		r.Get("/{ticker}", h.GetTickerPrice)
+		r.Get("/{ticker}/history", h.GetTickerPriceHistory)
 	})
 }
 
+// RegisterStreamRoutes registers only the live price feeds, which have no gateway equivalent
+func RegisterStreamRoutes(r chi.Router, service *Service) {
+	h := &Handler{Service: *service}
+
+	r.Get("/ticker-price/stream", h.StreamTickerPrices)
+	r.Get("/ticker-price/stream/ws", h.StreamTickerPricesWS)
+}
+
 // GetTickerPrice handles GET /ticker-price/{ticker}
 // @Summary      Get price of a ticker
-// @Description  Returns the current price of a ticker
+// @Description  Returns the latest price of a ticker from cache, TimescaleDB or the market data provider, with its source and as_of time
 // @Tags         ticker-price
 // @Produce      json
 // @Param        ticker  path  string  true  "Ticker Symbol"
-// @Success      200     {object}  TickerPrice
+// @Success      200     {object}  PriceQuote
 // @Failure      400     {string}  string  "Invalid ticker symbol"
 // @Router       /api/v1/ticker-price/{ticker} [get]
 func (h *Handler) GetTickerPrice(w http.ResponseWriter, r *http.Request) {
@@ -35,7 +50,11 @@ func (h *Handler) GetTickerPrice(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	tickerPrice := h.Service.FindBySymbol(tickerSymbol)
+	// stay inside the server's write timeout while queued behind the provider limiter
+	ctx, cancel := context.WithTimeout(r.Context(), providerRequestTimeout)
+	defer cancel()
+
+	tickerPrice := h.Service.GetLatestPrice(ctx, tickerSymbol)
 	if tickerPrice == nil {
 		http.Error(w, "Ticker not found", http.StatusNotFound)
 		return
@@ -47,3 +66,48 @@ func (h *Handler) GetTickerPrice(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+// GetTickerPriceHistory handles GET /ticker-price/{ticker}/history
+// @Summary      Get stored price history of a ticker
+// @Description  Returns raw stored prices, or OHLC candles when an interval such as 5m, 1h or 1d is given. Defaults to the last 7 days.
+// @Tags         ticker-price
+// @Produce      json
+// @Param        ticker    path   string  true   "Ticker Symbol"
+// @Param        from      query  string  false  "Start time (RFC3339)"
+// @Param        to        query  string  false  "End time (RFC3339)"
+// @Param        interval  query  string  false  "Bucket size, raw when omitted"
+// @Success      200       {object}  History
+// @Failure      400       {string}  string  "bad request"
+// @Router       /api/v1/ticker-price/{ticker}/history [get]
+func (h *Handler) GetTickerPriceHistory(w http.ResponseWriter, r *http.Request) {
+	tickerSymbol := chi.URLParam(r, "ticker")
+
+	query := r.URL.Query()
+	from, to, err := ParseTimeRange(query.Get("from"), query.Get("to"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	interval, err := ParseInterval(query.Get("interval"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	history, err := h.Service.GetHistory(tickerSymbol, from, to, interval)
+	if err != nil {
+		if errors.Is(err, ErrTooManyBuckets) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Failed to retrieve price history", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(history)
+	if err != nil {
+		return
+	}
+}
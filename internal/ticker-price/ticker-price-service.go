@@ -1,276 +1,458 @@
 package ticker_price
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"errors"
+	"github.com/khorzhenwin/gold-digger/internal/calendar"
 	"github.com/khorzhenwin/gold-digger/internal/config"
+	"github.com/khorzhenwin/gold-digger/internal/market-data"
 	"github.com/khorzhenwin/gold-digger/internal/models"
-	"github.com/khorzhenwin/gold-digger/internal/notification"
+	"github.com/khorzhenwin/gold-digger/internal/price-bus"
 	"github.com/khorzhenwin/gold-digger/internal/watchlist"
-	"io"
 	"log"
-	"net/http"
 	"os"
-	"strconv"
-	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
 type Service struct {
 	watchlistService      watchlist.Service
-	vantageConfig         config.VantageConfig
+	provider              market_data.QuoteProvider
 	tickerPriceRepository *Repository
-	selectedApiKeyIndex   *int
+	priceBus              *price_bus.Bus
+	priceCache            *PriceCache
+	freshness             time.Duration
+	now                   func() time.Time
 }
 
-func NewService(watchlistService *watchlist.Service, vantageConfig *config.VantageConfig, tickerPriceRepository *Repository) *Service {
-	return &Service{watchlistService: *watchlistService, vantageConfig: *vantageConfig, tickerPriceRepository: tickerPriceRepository, selectedApiKeyIndex: new(int)}
+const (
+	PriceSourceCache    = "cache"
+	PriceSourceDatabase = "database"
+	PriceSourceProvider = "provider"
+)
+
+// PriceQuote is a price along with where it was served from and how old it is
+type PriceQuote struct {
+	models.TickerPrice
+	Source string    `json:"source"`
+	AsOf   time.Time `json:"as_of"`
 }
 
-func (s *Service) FindBySymbol(symbol string) *models.TickerPrice {
-	apiKey := s.vantageConfig.ApiKey
-	if s.selectedApiKeyIndex != nil && *s.selectedApiKeyIndex > 0 && *s.selectedApiKeyIndex-1 < len(s.vantageConfig.ApiKeyBackups) {
-		apiKey = s.vantageConfig.ApiKeyBackups[*s.selectedApiKeyIndex-1]
+func NewService(watchlistService *watchlist.Service, provider market_data.QuoteProvider, tickerPriceRepository *Repository, priceBus *price_bus.Bus, priceCacheConfig *config.PriceCacheConfig) *Service {
+	return &Service{
+		watchlistService:      *watchlistService,
+		provider:              provider,
+		tickerPriceRepository: tickerPriceRepository,
+		priceBus:              priceBus,
+		priceCache:            NewPriceCache(),
+		freshness:             priceCacheConfig.Freshness,
+		now:                   time.Now,
 	}
-
-	vantageApiUrl := s.vantageConfig.GetGlobalQuoteUrl(symbol, apiKey)
-	tickerPrice, _ := fetchPrice(vantageApiUrl, symbol)
-	return tickerPrice
 }
 
-func (s *Service) getTickersFromWatchlist() ([]string, error) {
-	tickers, err := s.watchlistService.FindAll()
+// FindBySymbol always asks the provider, prefer GetLatestPrice to save API quota.
+// The call queues behind the provider's rate limiter until ctx is done.
+func (s *Service) FindBySymbol(ctx context.Context, symbol string) *models.TickerPrice {
+	exchange := s.exchangeFor(symbol)
+	tickerPrice, err := s.provider.GetQuote(ctx, symbol, exchange)
 	if err != nil {
-		return nil, err
+		log.Printf("❌ Error fetching %s from %s: %v", symbol, s.provider.Name(), err)
+		return nil
 	}
+	if tickerPrice != nil {
+		tickerPrice.Session = s.sessionOf(*tickerPrice, exchange)
+	}
+	return tickerPrice
+}
 
-	var symbols []string
-	for _, t := range tickers {
-		symbols = append(symbols, t.Symbol)
+// sessionOf labels a quote with the session its own timestamp falls in on exchange. Providers
+// without extended-hours data keep quoting the regular session's last price after the close,
+// so their quotes are never labelled pre or post.
+func (s *Service) sessionOf(price models.TickerPrice, exchange *calendar.Exchange) string {
+	session := exchange.SessionAt(price.Timestamp)
+	if (session == calendar.SessionPreMarket || session == calendar.SessionAfterHours) && !market_data.QuotesExtendedHours(s.provider) {
+		session = calendar.SessionRegular
 	}
+	return string(session)
+}
 
-	return symbols, nil
+// exchangeFor is the exchange of symbol's watchlist item, or the one its suffix suggests
+func (s *Service) exchangeFor(symbol string) *calendar.Exchange {
+	mic := calendar.InferMIC(symbol)
+	if ticker, err := s.watchlistService.FindBySymbol(symbol); err == nil && ticker != nil {
+		mic = ticker.Exchange
+	}
+	return exchangeOf(mic)
 }
 
-func fetchPrice(externalApiUrl string, symbol string) (*models.TickerPrice, error) {
-	resp, err := http.Get(externalApiUrl)
-	if err != nil {
-		log.Println(err)
-		return nil, fmt.Errorf("request failed: %w", err)
+// unknownExchanges remembers the exchange codes already logged by exchangeOf
+var unknownExchanges sync.Map
+
+// exchangeOf resolves mic, unknown codes are logged once and fall back to the NYSE calendar
+func exchangeOf(mic string) *calendar.Exchange {
+	exchange, ok := calendar.ForMIC(mic)
+	if !ok {
+		if _, warned := unknownExchanges.LoadOrStore(mic, true); !warned {
+			log.Printf("⚠️ Unknown exchange %q, its symbols are timed by the %s calendar", mic, calendar.NYSE.MIC())
+		}
+		return calendar.NYSE
 	}
+	return exchange
+}
 
-	defer func(Body io.ReadCloser) {
-		_ = Body.Close()
-	}(resp.Body)
+// GetLatestPrice reads through the in-process cache and TimescaleDB before calling the provider.
+// When the provider fails, the newest stale price is served instead, if there is one.
+func (s *Service) GetLatestPrice(ctx context.Context, symbol string) *PriceQuote {
+	var stale *PriceQuote
+	exchange := s.exchangeFor(symbol)
 
-	body, err := io.ReadAll(resp.Body)
+	if cached, ok := s.priceCache.Get(symbol); ok {
+		quote := newPriceQuote(cached, PriceSourceCache)
+		if s.isFresh(cached, exchange) {
+			return quote
+		}
+		stale = quote
+	}
 
+	latest, err := s.tickerPriceRepository.GetLatest(symbol, 1)
 	if err != nil {
-		log.Println(err)
-		return nil, fmt.Errorf("❌ failed to read response: %w", err)
+		log.Printf("⚠️ Failed to read latest price for %s: %v", symbol, err)
+	} else if len(latest) > 0 {
+		s.priceCache.Put(latest[0])
+		quote := newPriceQuote(latest[0], PriceSourceDatabase)
+		if s.isFresh(latest[0], exchange) {
+			return quote
+		}
+		if stale == nil || quote.AsOf.After(stale.AsOf) {
+			stale = quote
+		}
 	}
 
-	var raw map[string]interface{}
-	if err := json.Unmarshal(body, &raw); err != nil {
-		log.Printf("❌ Failed to unmarshal response: %v", err)
-		log.Printf("🔎 Raw response: %s", string(body))
-		return nil, fmt.Errorf("❌ failed to decode JSON: %w", err)
+	tickerPrice := s.FindBySymbol(ctx, symbol)
+	if tickerPrice == nil {
+		return stale
 	}
+	s.priceCache.Put(*tickerPrice)
+	return newPriceQuote(*tickerPrice, PriceSourceProvider)
+}
 
-	// Handle known error formats from Alpha Vantage
-	if note, ok := raw["Note"]; ok {
-		log.Printf("⚠️ Alpha Vantage Note: %v", note)
-		return nil, fmt.Errorf("rate limited or API error: %v", note)
+// isFresh reports whether price is recent enough to serve without asking the provider. While
+// exchange is closed, a price from its last close onwards is as new as the provider can return.
+func (s *Service) isFresh(price models.TickerPrice, exchange *calendar.Exchange) bool {
+	now := s.now()
+	if now.Sub(price.Timestamp) <= s.freshness {
+		return true
 	}
-	if errMsg, ok := raw["Error Message"]; ok {
-		log.Printf("⚠️ Alpha Vantage Error: %v", errMsg)
-		return nil, fmt.Errorf("api error: %v", errMsg)
+	return !exchange.IsOpen(now) && !price.Timestamp.Before(exchange.PreviousClose(now))
+}
+
+func newPriceQuote(price models.TickerPrice, source string) *PriceQuote {
+	return &PriceQuote{TickerPrice: price, Source: source, AsOf: price.Timestamp}
+}
+
+const pollInterval = 5 * time.Minute
+
+// pollWorkers fetch a poll's symbols in order, more of them than any provider allows in flight
+// so the provider's limiter rather than the poller sets the pace
+const pollWorkers = 8
+
+// streamBuffer is how many prices a slow stream subscriber may fall behind before the oldest are dropped
+const streamBuffer = 64
+
+// SubscribePrices follows every price the poller persists. Slow subscribers lose their oldest
+// prices rather than holding up the poller. Callers must release it with UnsubscribePrices.
+func (s *Service) SubscribePrices(name string) *price_bus.Subscription {
+	return s.priceBus.Subscribe(name, streamBuffer, price_bus.DropOldest)
+}
+
+func (s *Service) UnsubscribePrices(sub *price_bus.Subscription) {
+	s.priceBus.Unsubscribe(sub)
+}
+
+// GetRecentPrices returns up to limit of the latest stored prices for symbol, oldest first
+func (s *Service) GetRecentPrices(symbol string, limit int) ([]models.TickerPrice, error) {
+	prices, err := s.tickerPriceRepository.GetLatest(symbol, limit)
+	if err != nil {
+		return nil, err
+	}
+	for i, j := 0, len(prices)-1; i < j; i, j = i+1, j-1 {
+		prices[i], prices[j] = prices[j], prices[i]
 	}
+	return prices, nil
+}
+
+// maxHistoryBuckets bounds bucketed history queries so a tiny interval cannot scan years of buckets
+const maxHistoryBuckets = 5000
+
+type History struct {
+	Ticker   string               `json:"ticker"`
+	Interval string               `json:"interval"`
+	Prices   []models.TickerPrice `json:"prices,omitempty"`
+	Candles  []models.PriceCandle `json:"candles,omitempty"`
+}
 
-	// Convert the nested quote safely
-	globalQuote, ok := raw["Global Quote"].(map[string]interface{})
-	if !ok || len(globalQuote) == 0 {
-		formatErr := fmt.Errorf("⚠️ Missing or invalid Global Quote: %v", raw)
-		if raw["Information"] != nil {
-			formatErr = fmt.Errorf("❌ API error: %s", raw["Information"].(string))
+var ErrTooManyBuckets = errors.New("requested range holds too many buckets, widen the interval")
+
+// GetHistory reads stored prices, raw when interval is 0 or bucketed into OHLC candles otherwise
+func (s *Service) GetHistory(symbol string, from time.Time, to time.Time, interval time.Duration) (*History, error) {
+	if interval == 0 {
+		prices, err := s.tickerPriceRepository.GetRange(symbol, from, to)
+		if err != nil {
+			return nil, err
 		}
-		return nil, formatErr
+		return &History{Ticker: symbol, Interval: "raw", Prices: prices}, nil
 	}
 
-	price, _ := globalQuote["05. price"].(string)
-	timestamp, _ := globalQuote["07. latest trading day"].(string)
-
-	if price == "" || timestamp == "" {
-		return nil, fmt.Errorf("empty price or timestamp, skipping symbol %s", symbol)
+	if to.Sub(from)/interval > maxHistoryBuckets {
+		return nil, ErrTooManyBuckets
 	}
-	priceFloat, err := strconv.ParseFloat(price, 64)
-	parsedTimestamp, err := time.Parse("2006-01-02", timestamp)
 
+	candles, err := s.tickerPriceRepository.GetCandles(symbol, from, to, interval)
 	if err != nil {
-		log.Printf("❌ Failed to parse price or timestamp for %s: %v", symbol, err)
-		return nil, fmt.Errorf("failed to parse price or timestamp: %w", err)
+		return nil, err
 	}
+	return &History{Ticker: symbol, Interval: interval.String(), Candles: candles}, nil
+}
 
-	return &models.TickerPrice{
-		Symbol:    symbol,
-		Price:     priceFloat,
-		Timestamp: parsedTimestamp,
-	}, nil
+// pollTarget is a watchlist symbol with the exchange its prices are stamped by
+type pollTarget struct {
+	symbol   string
+	exchange *calendar.Exchange
 }
 
-func pollPrices(tickerService *Service, symbols []string, results chan<- models.TickerPrice) {
-	for _, symbol := range symbols {
-		go func(s string) {
-			apiKey := tickerService.vantageConfig.ApiKey
-			if tickerService.selectedApiKeyIndex != nil && *tickerService.selectedApiKeyIndex > 0 && *tickerService.selectedApiKeyIndex-1 < len(tickerService.vantageConfig.ApiKeyBackups) {
-				apiKey = tickerService.vantageConfig.ApiKeyBackups[*tickerService.selectedApiKeyIndex-1]
-			}
+// getTickersToPoll returns the watchlist symbols due at now under their session mode, or
+// every symbol when force is set
+func (s *Service) getTickersToPoll(now time.Time, force bool) ([]pollTarget, error) {
+	tickers, err := s.watchlistService.FindAll()
+	if err != nil {
+		return nil, err
+	}
 
-			vantageApiUrl := tickerService.vantageConfig.GetGlobalQuoteUrl(symbol, apiKey)
-			resp, err := fetchPrice(vantageApiUrl, s)
-			log.Printf("Raw response : %+v", resp)
+	var targets []pollTarget
+	for _, t := range tickers {
+		exchange := exchangeOf(t.Exchange)
+		if force || isPollDue(exchange, t.SessionMode, now) {
+			targets = append(targets, pollTarget{symbol: t.Symbol, exchange: exchange})
+		}
+	}
 
-			if err != nil {
-				log.Printf("❌ Error fetching %s: %v", s, err)
+	return targets, nil
+}
 
-				// if err contains substring of "API rate limit" switch to the next API key
-				if strings.Contains(err.Error(), "rate limit") {
-					*tickerService.selectedApiKeyIndex++
-					if *tickerService.selectedApiKeyIndex > len(tickerService.vantageConfig.ApiKeyBackups) {
-						*tickerService.selectedApiKeyIndex = 0
-					}
-					return
-				}
-				return
-			}
+func isPollDue(exchange *calendar.Exchange, sessionMode string, now time.Time) bool {
+	switch sessionMode {
+	case models.SessionModeAlways:
+		return true
+	case models.SessionModeExtended:
+		return exchange.IsExtendedOpen(now)
+	default:
+		return exchange.IsOpen(now)
+	}
+}
 
-			if resp == nil {
-				log.Printf("⚠️ Skipping %s due to nil response", s)
-				return
-			}
+// deferredSymbols are the symbols a poll could not fetch before the next one was due. They go
+// first in the next poll, so a watchlist larger than the provider's quota rotates through
+// instead of the same symbols missing out every time.
+type deferredSymbols struct {
+	mu      sync.Mutex
+	symbols map[string]bool
+}
 
-			results <- *resp
-		}(symbol)
+func (d *deferredSymbols) add(symbol string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.symbols == nil {
+		d.symbols = make(map[string]bool)
 	}
+	d.symbols[symbol] = true
 }
 
-func (s *Service) PollAndPersist() {
-	// poll every 5 minutes
-	ticker := time.NewTicker(5 * time.Minute)
-	defer ticker.Stop()
-
-	results := make(chan models.TickerPrice)
+// prioritise moves the deferred symbols to the front of targets and forgets them
+func (d *deferredSymbols) prioritise(targets []pollTarget) []pollTarget {
+	d.mu.Lock()
+	defer d.mu.Unlock()
 
-	log.Println("📈 Ticker-price fetcher started")
+	ordered := make([]pollTarget, 0, len(targets))
+	var rest []pollTarget
+	for _, target := range targets {
+		if d.symbols[target.symbol] {
+			ordered = append(ordered, target)
+		} else {
+			rest = append(rest, target)
+		}
+	}
+	d.symbols = nil
+	return append(ordered, rest...)
+}
 
-	// Start first run immediately
-	tickerList, _ := s.getTickersFromWatchlist()
-	go pollPrices(s, tickerList, results)
+// pollPrices fetches the targets in order behind the provider's limiter and labels each price
+// with the session of its timestamp on the symbol's exchange. Requests still queued when the next
+// poll is due are abandoned and their symbols deferred to it, as are those never dispatched.
+func pollPrices(ctx context.Context, tickerService *Service, targets []pollTarget, deferred *deferredSymbols, results chan<- models.TickerPrice, inFlight *sync.WaitGroup) {
+	pollCtx, cancel := context.WithTimeout(ctx, pollInterval)
+	queue := make(chan pollTarget)
+	var deferredCount atomic.Int64
+	deferTarget := func(target pollTarget) {
+		deferred.add(target.symbol)
+		deferredCount.Add(1)
+	}
 
-	for {
-		select {
-		case res := <-results:
-			bytes, _ := json.Marshal(res)
-			log.Printf("✅ Price: %s", bytes)
-
-			// save to TSDB
-			err := s.tickerPriceRepository.Save(models.TickerPrice{
-				Symbol:    res.Symbol,
-				Price:     res.Price,
-				Timestamp: res.Timestamp,
-			})
-
-			if err != nil {
-				log.Printf("❌ Failed to save price for) %s: %v", res.Symbol, err)
+	inFlight.Add(1)
+	go func() {
+		defer inFlight.Done()
+		defer close(queue)
+		for i, target := range targets {
+			select {
+			case queue <- target:
+			case <-pollCtx.Done():
+				for _, rest := range targets[i:] {
+					deferTarget(rest)
+				}
 				return
 			}
-			log.Printf("✅ Saved price for %s at %s", res.Symbol, res.Timestamp)
-		case <-ticker.C:
-			if IsTradingHours(time.Now()) || os.Getenv("FORCE_POLL") == "true" {
-				log.Println("🔄 Polling watchlist...")
-				go pollPrices(s, tickerList, results)
-			}
 		}
+	}()
+
+	var wg sync.WaitGroup
+	for range min(pollWorkers, len(targets)) {
+		wg.Add(1)
+		inFlight.Add(1)
+		go func() {
+			defer inFlight.Done()
+			defer wg.Done()
+			for target := range queue {
+				resp, err := tickerService.provider.GetQuote(pollCtx, target.symbol, target.exchange)
+				log.Printf("Raw response : %+v", resp)
+
+				if err != nil {
+					if pollCtx.Err() != nil && ctx.Err() == nil {
+						deferTarget(target)
+						continue
+					}
+					log.Printf("❌ Error fetching %s from %s: %v", target.symbol, tickerService.provider.Name(), err)
+					continue
+				}
+
+				if resp == nil {
+					log.Printf("⚠️ Skipping %s due to nil response", target.symbol)
+					continue
+				}
+
+				resp.Session = tickerService.sessionOf(*resp, target.exchange)
+				results <- *resp
+			}
+		}()
 	}
+
+	go func() {
+		wg.Wait()
+		cancel()
+		if n := deferredCount.Load(); n > 0 && ctx.Err() == nil {
+			log.Printf("⏭️ %d of %d symbols did not get through the %s rate limit in time, deferred to the next poll", n, len(targets), tickerService.provider.Name())
+		}
+	}()
 }
 
-// StartSignalWorker Refer to ADR-001
-func StartSignalWorker(input <-chan models.TickerPrice, notificationService *notification.Service) {
-	type PriceEntry struct {
-		Timestamp time.Time
-		Price     float64
-	}
+// PollAndPersist polls the watchlist until ctx is cancelled. On shutdown it stops polling,
+// saves every price still being fetched and then closes the price bus so subscribers drain.
+func (s *Service) PollAndPersist(ctx context.Context) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	defer s.priceBus.Close()
 
 	var (
-		priceWindows = make(map[string][]PriceEntry)
-		lastSignal   = make(map[string]time.Time)
-		mu           sync.Mutex
+		results  = make(chan models.TickerPrice)
+		inFlight sync.WaitGroup
+		deferred deferredSymbols
+		// last is the newest price persisted per symbol, only the loop below touches it
+		last = make(map[string]models.TickerPrice)
 	)
 
-	evaluateSignal := func(symbol string, window []PriceEntry) {
-		now := time.Now()
-		if len(window) < 5 {
+	log.Println("📈 Ticker-price fetcher started")
+
+	// each symbol is polled by its session mode on its own exchange's calendar, the watchlist is
+	// reloaded every round so new items and changes apply without a restart
+	force := os.Getenv("FORCE_POLL") == "true"
+	poll := func() {
+		tickerList, err := s.getTickersToPoll(time.Now(), force)
+		if err != nil {
+			log.Printf("❌ Failed to load the watchlist: %v", err)
+			return
+		}
+		if len(tickerList) == 0 {
 			return
 		}
+		log.Printf("🔄 Polling %d symbols...", len(tickerList))
+		pollPrices(ctx, s, deferred.prioritise(tickerList), &deferred, results, &inFlight)
+	}
 
-		oldest := window[0]
-		latest := window[len(window)-1]
-		totalChange := (latest.Price - oldest.Price) / oldest.Price
+	// Start first run immediately
+	poll()
 
-		increaseCount, decreaseCount := 0, 0
-		for i := 1; i < len(window); i++ {
-			if window[i].Price > window[i-1].Price {
-				increaseCount++
-			} else if window[i].Price < window[i-1].Price {
-				decreaseCount++
+	for {
+		select {
+		case res := <-results:
+			s.persist(res, last)
+		case <-ticker.C:
+			poll()
+		case <-ctx.Done():
+			log.Println("⏳ Draining in-flight price fetches...")
+			drained := make(chan struct{})
+			go func() {
+				inFlight.Wait()
+				close(drained)
+			}()
+
+			for {
+				select {
+				case res := <-results:
+					s.persist(res, last)
+				case <-drained:
+					return ctx.Err()
+				}
 			}
 		}
+	}
+}
 
-		if last, ok := lastSignal[symbol]; ok && now.Sub(last) < time.Hour {
-			return
-		}
-
-		var message string
-		if totalChange >= 0.02 && increaseCount >= 4 {
-			message = fmt.Sprintf("🚀 BUY SIGNAL for %s - Strong uptrend (%.2f%% increase)", symbol, totalChange*100)
-		} else if totalChange <= -0.02 && decreaseCount >= 4 {
-			message = fmt.Sprintf("🔻 BOGDANOFF HAS DOUMP IT. BUY THE DIP for %s - Strong downtrend (%.2f%% decrease)", symbol, totalChange*100)
-		} else {
-			return
-		}
+// persist saves and publishes res unless it repeats the symbol's last persisted quote, which is
+// what a provider without extended-hours data returns when polled after the close
+func (s *Service) persist(res models.TickerPrice, last map[string]models.TickerPrice) {
+	bytes, _ := json.Marshal(res)
+	log.Printf("✅ Price: %s", bytes)
 
-		log.Println(message)
-		if err := notificationService.Send(message); err != nil {
-			log.Printf("⚠️ Failed to send notification: %v", err)
-			return
+	previous, ok := last[res.Symbol]
+	if !ok {
+		if latest, err := s.tickerPriceRepository.GetLatest(res.Symbol, 1); err == nil && len(latest) > 0 {
+			previous, ok = latest[0], true
 		}
-		lastSignal[symbol] = now
+	}
+	if ok && previous.Price == res.Price && previous.Timestamp.Equal(res.Timestamp) {
+		log.Printf("⏸️ Skipping %s, the quote has not changed since %s", res.Symbol, res.Timestamp.Format(time.RFC3339))
+		last[res.Symbol] = previous
+		return
 	}
 
-	go func() {
-		ticker := time.NewTicker(15 * time.Minute)
-		defer ticker.Stop()
+	// save to TSDB
+	err := s.tickerPriceRepository.Save(models.TickerPrice{
+		Symbol:    res.Symbol,
+		Price:     res.Price,
+		Volume:    res.Volume,
+		Timestamp: res.Timestamp,
+		Session:   res.Session,
+	})
 
-		for {
-			select {
-			case msg := <-input:
-				entry := PriceEntry{Timestamp: msg.Timestamp, Price: msg.Price}
+	if err != nil {
+		log.Printf("❌ Failed to save price for %s: %v", res.Symbol, err)
+		return
+	}
+	log.Printf("✅ Saved price for %s at %s", res.Symbol, res.Timestamp)
+	last[res.Symbol] = res
 
-				mu.Lock()
-				priceWindows[msg.Symbol] = append(priceWindows[msg.Symbol], entry)
-				if len(priceWindows[msg.Symbol]) > 10 {
-					priceWindows[msg.Symbol] = priceWindows[msg.Symbol][len(priceWindows[msg.Symbol])-10:]
-				}
-				mu.Unlock()
+	s.priceCache.Put(res)
 
-			case <-ticker.C:
-				mu.Lock()
-				for symbol, window := range priceWindows {
-					evaluateSignal(symbol, window)
-				}
-				mu.Unlock()
-			}
-		}
-	}()
+	// fan out to the signal worker and any other subscribers
+	s.priceBus.Publish(res)
 }
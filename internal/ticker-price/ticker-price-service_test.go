@@ -0,0 +1,188 @@
+package ticker_price
+
+import (
+	"context"
+	"errors"
+	"github.com/khorzhenwin/gold-digger/internal/calendar"
+	"github.com/khorzhenwin/gold-digger/internal/config"
+	"github.com/khorzhenwin/gold-digger/internal/market-data"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"github.com/khorzhenwin/gold-digger/internal/watchlist"
+	"sync"
+	"testing"
+	"time"
+)
+
+// watchlistStub is a read-only watchlist.Storage over a fixed set of tickers
+type watchlistStub []models.Ticker
+
+func (w watchlistStub) Create(*models.Ticker) error          { return errors.New("read only") }
+func (w watchlistStub) GetAll() ([]models.Ticker, error)     { return w, nil }
+func (w watchlistStub) GetByID(uint) (*models.Ticker, error) { return nil, nil }
+func (w watchlistStub) Update(uint, models.Ticker) error     { return errors.New("read only") }
+func (w watchlistStub) Delete(uint) error                    { return errors.New("read only") }
+func (w watchlistStub) GetBySymbol(symbol string) (*models.Ticker, error) {
+	for _, ticker := range w {
+		if ticker.Symbol == symbol {
+			return &ticker, nil
+		}
+	}
+	return nil, nil
+}
+
+func newTestService(provider market_data.QuoteProvider, tickers ...models.Ticker) *Service {
+	return NewService(watchlist.NewService(watchlistStub(tickers), nil), provider, nil, nil, &config.PriceCacheConfig{Freshness: time.Minute})
+}
+
+func TestFindBySymbolLabelsSession(t *testing.T) {
+	newYork := calendar.NYSE.Location()
+	tests := []struct {
+		name          string
+		symbol        string
+		timestamp     time.Time
+		extendedHours bool
+		want          calendar.MarketSession
+	}{
+		{name: "regular session", symbol: "PLTR", timestamp: time.Date(2026, time.October, 16, 11, 0, 0, 0, newYork), want: calendar.SessionRegular},
+		{name: "after hours", symbol: "PLTR", timestamp: time.Date(2026, time.October, 16, 18, 0, 0, 0, newYork), extendedHours: true, want: calendar.SessionAfterHours},
+		{name: "after hours without extended-hours data", symbol: "PLTR", timestamp: time.Date(2026, time.October, 16, 18, 0, 0, 0, newYork), want: calendar.SessionRegular},
+		{name: "pre-market", symbol: "PLTR", timestamp: time.Date(2026, time.October, 16, 7, 0, 0, 0, newYork), extendedHours: true, want: calendar.SessionPreMarket},
+		{name: "on the watchlist item's exchange", symbol: "D05.SI", timestamp: time.Date(2026, time.October, 16, 3, 0, 0, 0, time.UTC), want: calendar.SessionRegular},
+		{name: "weekend", symbol: "PLTR", timestamp: time.Date(2026, time.October, 17, 11, 0, 0, 0, newYork), extendedHours: true, want: calendar.SessionClosed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := market_data.NewFakeProvider()
+			fake.SetExtendedHours(tt.extendedHours)
+			fake.SetQuote(models.TickerPrice{Symbol: tt.symbol, Price: 100, Timestamp: tt.timestamp})
+			service := newTestService(fake, models.Ticker{Symbol: "D05.SI", Exchange: "XSES"})
+
+			price := service.FindBySymbol(context.Background(), tt.symbol)
+			if price == nil {
+				t.Fatal("got no price")
+			}
+			if price.Session != string(tt.want) {
+				t.Errorf("got session %q, want %q", price.Session, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindBySymbolProviderError(t *testing.T) {
+	fake := market_data.NewFakeProvider()
+	fake.SetError("PLTR", errors.New("quota exhausted"))
+
+	if price := newTestService(fake).FindBySymbol(context.Background(), "PLTR"); price != nil {
+		t.Errorf("got %+v, want no price when the provider fails", price)
+	}
+}
+
+func TestPollPrices(t *testing.T) {
+	timestamp := time.Date(2026, time.October, 16, 14, 0, 0, 0, calendar.NYSE.Location())
+	fake := market_data.NewFakeProvider()
+	fake.SetQuote(models.TickerPrice{Symbol: "PLTR", Price: 180.5, Timestamp: timestamp})
+	fake.SetQuote(models.TickerPrice{Symbol: "TEM", Price: 72.1, Timestamp: timestamp})
+	fake.SetError("SOUN", errors.New("quota exhausted"))
+	service := newTestService(fake)
+
+	targets := []pollTarget{
+		{symbol: "PLTR", exchange: calendar.NYSE},
+		{symbol: "SOUN", exchange: calendar.NYSE},
+		{symbol: "TEM", exchange: calendar.NYSE},
+	}
+	deferred := &deferredSymbols{}
+	results := make(chan models.TickerPrice, len(targets))
+	var inFlight sync.WaitGroup
+	pollPrices(context.Background(), service, targets, deferred, results, &inFlight)
+	inFlight.Wait()
+	close(results)
+
+	got := make(map[string]models.TickerPrice)
+	for price := range results {
+		got[price.Symbol] = price
+	}
+	if len(got) != 2 || got["PLTR"].Price != 180.5 || got["TEM"].Price != 72.1 {
+		t.Errorf("got %+v, want the PLTR and TEM quotes", got)
+	}
+	for symbol, price := range got {
+		if price.Session != string(calendar.SessionRegular) {
+			t.Errorf("%s: got session %q, want regular", symbol, price.Session)
+		}
+	}
+	// a provider error is not a missed rate limit, so SOUN waits for its turn
+	if ordered := deferred.prioritise(targets); ordered[0].symbol != "PLTR" {
+		t.Errorf("got %s first in the next poll, want nothing deferred", ordered[0].symbol)
+	}
+}
+
+func TestDeferredSymbolsGoFirst(t *testing.T) {
+	targets := []pollTarget{{symbol: "PLTR"}, {symbol: "SOUN"}, {symbol: "TEM"}, {symbol: "D05.SI"}}
+	deferred := &deferredSymbols{}
+	deferred.add("TEM")
+	deferred.add("D05.SI")
+
+	ordered := deferred.prioritise(targets)
+	var symbols []string
+	for _, target := range ordered {
+		symbols = append(symbols, target.symbol)
+	}
+	want := []string{"TEM", "D05.SI", "PLTR", "SOUN"}
+	for i := range want {
+		if symbols[i] != want[i] {
+			t.Fatalf("got %v, want %v", symbols, want)
+		}
+	}
+	if again := deferred.prioritise(targets); again[0].symbol != "PLTR" {
+		t.Errorf("got %s first after prioritising, want deferred symbols forgotten", again[0].symbol)
+	}
+}
+
+func TestIsFresh(t *testing.T) {
+	newYork := calendar.NYSE.Location()
+	fridayClose := time.Date(2026, time.October, 16, 16, 0, 0, 0, newYork)
+	tests := []struct {
+		name      string
+		exchange  *calendar.Exchange
+		now       time.Time
+		timestamp time.Time
+		want      bool
+	}{
+		{name: "within the freshness window", exchange: calendar.NYSE, now: time.Date(2026, time.October, 16, 11, 0, 30, 0, newYork), timestamp: time.Date(2026, time.October, 16, 11, 0, 0, 0, newYork), want: true},
+		{name: "older than the window while open", exchange: calendar.NYSE, now: time.Date(2026, time.October, 16, 11, 5, 0, 0, newYork), timestamp: time.Date(2026, time.October, 16, 11, 0, 0, 0, newYork)},
+		{name: "the close price after the close", exchange: calendar.NYSE, now: time.Date(2026, time.October, 16, 19, 0, 0, 0, newYork), timestamp: fridayClose, want: true},
+		{name: "the close price at the weekend", exchange: calendar.NYSE, now: time.Date(2026, time.October, 18, 12, 0, 0, 0, newYork), timestamp: fridayClose, want: true},
+		{name: "the close price before a holiday", exchange: calendar.NYSE, now: time.Date(2026, time.November, 26, 12, 0, 0, 0, newYork), timestamp: time.Date(2026, time.November, 25, 16, 0, 0, 0, newYork), want: true},
+		{name: "a price from before the last close", exchange: calendar.NYSE, now: time.Date(2026, time.October, 18, 12, 0, 0, 0, newYork), timestamp: time.Date(2026, time.October, 16, 15, 0, 0, 0, newYork)},
+		{name: "the close price before the next open", exchange: calendar.NYSE, now: time.Date(2026, time.October, 19, 9, 0, 0, 0, newYork), timestamp: fridayClose, want: true},
+		{name: "the close price once the market reopens", exchange: calendar.NYSE, now: time.Date(2026, time.October, 19, 9, 31, 0, 0, newYork), timestamp: fridayClose},
+		{name: "crypto never closes", exchange: calendar.Crypto, now: time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC), timestamp: time.Date(2026, time.October, 18, 11, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := newTestService(market_data.NewFakeProvider())
+			service.now = func() time.Time { return tt.now }
+			if got := service.isFresh(models.TickerPrice{Symbol: "PLTR", Timestamp: tt.timestamp}, tt.exchange); got != tt.want {
+				t.Errorf("got fresh %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetLatestPriceServesTheCloseAtTheWeekend(t *testing.T) {
+	fridayClose := time.Date(2026, time.October, 16, 16, 0, 0, 0, calendar.NYSE.Location())
+	fake := market_data.NewFakeProvider()
+	fake.SetQuote(models.TickerPrice{Symbol: "PLTR", Price: 999, Timestamp: fridayClose})
+	service := newTestService(fake)
+	service.now = func() time.Time { return fridayClose.AddDate(0, 0, 2) }
+	service.priceCache.Put(models.TickerPrice{Symbol: "PLTR", Price: 180.5, Timestamp: fridayClose})
+
+	quote := service.GetLatestPrice(context.Background(), "PLTR")
+	if quote == nil || quote.Source != PriceSourceCache || quote.Price != 180.5 {
+		t.Errorf("got %+v, want the cached close", quote)
+	}
+	if calls := fake.Calls(); len(calls) != 0 {
+		t.Errorf("got %d calls to the provider, want none while the market is closed", len(calls))
+	}
+}
@@ -0,0 +1,408 @@
+package ticker_price
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/gorilla/websocket"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"github.com/khorzhenwin/gold-digger/internal/price-bus"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	streamHeartbeat    = 15 * time.Second
+	streamWriteTimeout = 10 * time.Second
+	maxStreamSymbols   = 50
+	// maxResumeLookback caps how far back a Last-Event-ID resume reads from the hypertable
+	maxResumeLookback = 24 * time.Hour
+)
+
+// StreamEvent is one price pushed over SSE or WebSocket. ID is the price's timestamp in
+// microseconds and its symbol, such as 1760626800000000-PLTR, clients send it back as
+// Last-Event-ID to resume after a reconnect.
+type StreamEvent struct {
+	ID    string             `json:"id"`
+	Price models.TickerPrice `json:"price"`
+}
+
+// streamCommand lets a WebSocket client change its symbol filter without reconnecting
+type streamCommand struct {
+	Action  string   `json:"action"` // subscribe or unsubscribe
+	Symbols []string `json:"symbols"`
+}
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+func newStreamEvent(price models.TickerPrice) StreamEvent {
+	return StreamEvent{ID: eventKeyOf(price).String(), Price: price}
+}
+
+// eventKey identifies a price in the stream. A poll stamps many symbols with the same
+// timestamp, so the timestamp alone does not tell the prices of one batch apart.
+type eventKey struct {
+	micros int64
+	symbol string
+}
+
+func eventKeyOf(price models.TickerPrice) eventKey {
+	return eventKey{micros: price.Timestamp.UnixMicro(), symbol: price.Symbol}
+}
+
+func (k eventKey) String() string {
+	return strconv.FormatInt(k.micros, 10) + "-" + k.symbol
+}
+
+// precedes reports whether the client that received the event k has seen price. The prices
+// of a batch reach the bus in no particular order, so of the prices sharing k's timestamp only
+// k itself counts as seen and the rest are replayed, at the cost of the odd duplicate.
+func (k eventKey) precedes(price models.TickerPrice) bool {
+	micros := price.Timestamp.UnixMicro()
+	return micros < k.micros || (micros == k.micros && price.Symbol == k.symbol)
+}
+
+// symbolFilter is the set of symbols one connection follows
+type symbolFilter struct {
+	mu      sync.RWMutex
+	symbols map[string]struct{}
+}
+
+func newSymbolFilter(symbols []string) *symbolFilter {
+	f := &symbolFilter{symbols: make(map[string]struct{}, len(symbols))}
+	f.add(symbols)
+	return f
+}
+
+func (f *symbolFilter) add(symbols []string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, symbol := range symbols {
+		f.symbols[symbol] = struct{}{}
+	}
+}
+
+func (f *symbolFilter) remove(symbols []string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, symbol := range symbols {
+		delete(f.symbols, symbol)
+	}
+}
+
+func (f *symbolFilter) has(symbol string) bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	_, ok := f.symbols[symbol]
+	return ok
+}
+
+func (f *symbolFilter) size() int {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return len(f.symbols)
+}
+
+// ParseSymbols splits a comma separated symbol list, dropping blanks and duplicates
+func ParseSymbols(raw string) ([]string, error) {
+	seen := make(map[string]struct{})
+	var symbols []string
+	for _, part := range strings.Split(raw, ",") {
+		symbol := strings.TrimSpace(part)
+		if symbol == "" {
+			continue
+		}
+		if _, ok := seen[symbol]; ok {
+			continue
+		}
+		seen[symbol] = struct{}{}
+		symbols = append(symbols, symbol)
+	}
+
+	if len(symbols) == 0 {
+		return nil, fmt.Errorf("at least one symbol is required")
+	}
+	if len(symbols) > maxStreamSymbols {
+		return nil, fmt.Errorf("at most %d symbols can be streamed per connection", maxStreamSymbols)
+	}
+	return symbols, nil
+}
+
+// parseLastEventID reads the resume point from the Last-Event-ID header, or the last_event_id
+// query parameter for clients such as browser WebSockets that cannot set headers. IDs sent before
+// events carried a symbol are plain timestamps and resume from every price at that timestamp.
+func parseLastEventID(r *http.Request) (eventKey, bool, error) {
+	raw := r.Header.Get("Last-Event-ID")
+	if raw == "" {
+		raw = r.URL.Query().Get("last_event_id")
+	}
+	if raw == "" {
+		return eventKey{}, false, nil
+	}
+
+	rawMicros, symbol, _ := strings.Cut(raw, "-")
+	micros, err := strconv.ParseInt(rawMicros, 10, 64)
+	if err != nil {
+		return eventKey{}, false, fmt.Errorf("invalid Last-Event-ID %q", raw)
+	}
+	return eventKey{micros: micros, symbol: symbol}, true, nil
+}
+
+// GetPricesAfter returns the stored prices of symbols the client that received the event after
+// has not seen, oldest first. Resuming is capped to maxResumeLookback.
+func (s *Service) GetPricesAfter(symbols []string, after eventKey) ([]models.TickerPrice, error) {
+	since := time.UnixMicro(after.micros)
+	if earliest := time.Now().Add(-maxResumeLookback); since.Before(earliest) {
+		since = earliest
+	}
+
+	var prices []models.TickerPrice
+	for _, symbol := range symbols {
+		stored, err := s.tickerPriceRepository.GetSince(symbol, since)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range stored {
+			if !after.precedes(p) {
+				prices = append(prices, p)
+			}
+		}
+	}
+
+	sort.SliceStable(prices, func(i, j int) bool {
+		if !prices[i].Timestamp.Equal(prices[j].Timestamp) {
+			return prices[i].Timestamp.Before(prices[j].Timestamp)
+		}
+		return prices[i].Symbol < prices[j].Symbol
+	})
+	return prices, nil
+}
+
+// subscribeAndResume subscribes to the price bus before reading missed prices, so nothing
+// persisted in between is lost. It returns the last replayed timestamp per symbol for deduping.
+func (h *Handler) subscribeAndResume(r *http.Request, name string, symbols []string) (*price_bus.Subscription, []models.TickerPrice, map[string]time.Time, error) {
+	sub := h.Service.SubscribePrices(name)
+	replayedUntil := make(map[string]time.Time)
+
+	after, resume, err := parseLastEventID(r)
+	if err != nil || !resume {
+		return sub, nil, replayedUntil, nil
+	}
+
+	missed, err := h.Service.GetPricesAfter(symbols, after)
+	if err != nil {
+		h.Service.UnsubscribePrices(sub)
+		return nil, nil, nil, err
+	}
+	for _, p := range missed {
+		replayedUntil[p.Symbol] = p.Timestamp
+	}
+	return sub, missed, replayedUntil, nil
+}
+
+func alreadyReplayed(replayedUntil map[string]time.Time, price models.TickerPrice) bool {
+	until, ok := replayedUntil[price.Symbol]
+	return ok && price.Timestamp.UnixMicro() <= until.UnixMicro()
+}
+
+// StreamTickerPrices handles GET /ticker-price/stream
+// @Summary      Stream live prices over Server-Sent Events
+// @Description  Pushes every newly polled price of the requested symbols as a `price` event, with a comment heartbeat every 15s. Reconnecting with Last-Event-ID replays missed prices from TimescaleDB, up to 24h back.
+// @Tags         ticker-price
+// @Produce      text/event-stream
+// @Param        symbols        query   string  true   "Comma separated symbols"
+// @Param        Last-Event-ID  header  string  false  "ID of the last event received"
+// @Success      200  {object}  StreamEvent
+// @Failure      400  {string}  string  "bad request"
+// @Router       /api/v1/ticker-price/stream [get]
+func (h *Handler) StreamTickerPrices(w http.ResponseWriter, r *http.Request) {
+	symbols, err := ParseSymbols(r.URL.Query().Get("symbols"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if _, _, err := parseLastEventID(r); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// the stream outlives the server's write timeout
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	sub, missed, replayedUntil, err := h.subscribeAndResume(r, "sse-stream", symbols)
+	if err != nil {
+		http.Error(w, "Failed to resume price stream", http.StatusInternalServerError)
+		return
+	}
+	defer h.Service.UnsubscribePrices(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	filter := newSymbolFilter(symbols)
+	send := func(price models.TickerPrice) error {
+		event := newStreamEvent(price)
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "id: %s\nevent: price\ndata: %s\n\n", event.ID, data); err != nil {
+			return err
+		}
+		return rc.Flush()
+	}
+
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", (5 * time.Second).Milliseconds()); err != nil {
+		return
+	}
+	for _, p := range missed {
+		if send(p) != nil {
+			return
+		}
+	}
+	if rc.Flush() != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil || rc.Flush() != nil {
+				return
+			}
+		case p, ok := <-sub.C():
+			if !ok {
+				return
+			}
+			if !filter.has(p.Symbol) || alreadyReplayed(replayedUntil, p) {
+				continue
+			}
+			if err := send(p); err != nil {
+				log.Printf("⚠️ SSE client dropped: %v", err)
+				return
+			}
+		}
+	}
+}
+
+// StreamTickerPricesWS handles GET /ticker-price/stream/ws
+// @Summary      Stream live prices over WebSocket
+// @Description  Upgrades to a WebSocket that pushes StreamEvent JSON messages for the requested symbols, pinging every 15s. Send {"action":"subscribe"|"unsubscribe","symbols":[...]} to change the filter. Pass last_event_id to replay missed prices.
+// @Tags         ticker-price
+// @Param        symbols        query  string  true   "Comma separated symbols"
+// @Param        last_event_id  query  string  false  "ID of the last event received"
+// @Success      101  {object}  StreamEvent
+// @Failure      400  {string}  string  "bad request"
+// @Router       /api/v1/ticker-price/stream/ws [get]
+func (h *Handler) StreamTickerPricesWS(w http.ResponseWriter, r *http.Request) {
+	symbols, err := ParseSymbols(r.URL.Query().Get("symbols"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if _, _, err := parseLastEventID(r); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader already replied with an error
+		return
+	}
+	defer conn.Close()
+
+	sub, missed, replayedUntil, err := h.subscribeAndResume(r, "ws-stream", symbols)
+	if err != nil {
+		_ = conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseInternalServerErr, "failed to resume price stream"),
+			time.Now().Add(streamWriteTimeout))
+		return
+	}
+	defer h.Service.UnsubscribePrices(sub)
+
+	filter := newSymbolFilter(symbols)
+
+	// the reader handles filter commands and pongs, and notices when the client goes away
+	closed := make(chan struct{})
+	_ = conn.SetReadDeadline(time.Now().Add(2 * streamHeartbeat))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(2 * streamHeartbeat))
+	})
+	go func() {
+		defer close(closed)
+		for {
+			var cmd streamCommand
+			if err := conn.ReadJSON(&cmd); err != nil {
+				return
+			}
+			switch cmd.Action {
+			case "subscribe":
+				filter.add(cmd.Symbols)
+				if filter.size() > maxStreamSymbols {
+					filter.remove(cmd.Symbols)
+				}
+			case "unsubscribe":
+				filter.remove(cmd.Symbols)
+			}
+		}
+	}()
+
+	send := func(price models.TickerPrice) error {
+		_ = conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+		return conn.WriteJSON(newStreamEvent(price))
+	}
+
+	for _, p := range missed {
+		if send(p) != nil {
+			return
+		}
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case <-heartbeat.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(streamWriteTimeout)); err != nil {
+				return
+			}
+		case p, ok := <-sub.C():
+			if !ok {
+				_ = conn.WriteControl(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down"),
+					time.Now().Add(streamWriteTimeout))
+				return
+			}
+			if !filter.has(p.Symbol) || alreadyReplayed(replayedUntil, p) {
+				continue
+			}
+			if err := send(p); err != nil {
+				log.Printf("⚠️ WebSocket client dropped: %v", err)
+				return
+			}
+		}
+	}
+}
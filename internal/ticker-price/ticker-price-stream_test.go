@@ -0,0 +1,66 @@
+package ticker_price
+
+import (
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseLastEventID(t *testing.T) {
+	timestamp := time.Date(2026, time.October, 16, 14, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		header  string
+		query   string
+		want    eventKey
+		resume  bool
+		wantErr bool
+	}{
+		{name: "no resume"},
+		{name: "header", header: newStreamEvent(models.TickerPrice{Symbol: "PLTR", Timestamp: timestamp}).ID, want: eventKey{micros: timestamp.UnixMicro(), symbol: "PLTR"}, resume: true},
+		{name: "symbol with a dash", header: newStreamEvent(models.TickerPrice{Symbol: "BTC-USD", Timestamp: timestamp}).ID, want: eventKey{micros: timestamp.UnixMicro(), symbol: "BTC-USD"}, resume: true},
+		{name: "query parameter", query: "1760623200000000-D05.SI", want: eventKey{micros: 1760623200000000, symbol: "D05.SI"}, resume: true},
+		{name: "timestamp only", header: "1760623200000000", want: eventKey{micros: 1760623200000000}, resume: true},
+		{name: "not a timestamp", header: "PLTR-1760623200000000", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/api/v1/ticker-price/stream?symbols=PLTR&last_event_id="+tt.query, nil)
+			if tt.header != "" {
+				r.Header.Set("Last-Event-ID", tt.header)
+			}
+
+			got, resume, err := parseLastEventID(r)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %t", err, tt.wantErr)
+			}
+			if got != tt.want || resume != tt.resume {
+				t.Errorf("got %+v and resume %t, want %+v and %t", got, resume, tt.want, tt.resume)
+			}
+		})
+	}
+}
+
+func TestEventKeyResumesMidBatch(t *testing.T) {
+	batch := time.Date(2026, time.October, 16, 14, 0, 0, 0, time.UTC)
+	last := eventKeyOf(models.TickerPrice{Symbol: "SOUN", Timestamp: batch})
+
+	tests := []struct {
+		price models.TickerPrice
+		seen  bool
+	}{
+		{price: models.TickerPrice{Symbol: "PLTR", Timestamp: batch.Add(-time.Minute)}, seen: true},
+		{price: models.TickerPrice{Symbol: "SOUN", Timestamp: batch}, seen: true},
+		{price: models.TickerPrice{Symbol: "PLTR", Timestamp: batch}},
+		{price: models.TickerPrice{Symbol: "TEM", Timestamp: batch}},
+		{price: models.TickerPrice{Symbol: "SOUN", Timestamp: batch.Add(time.Microsecond)}},
+	}
+
+	for _, tt := range tests {
+		if seen := last.precedes(tt.price); seen != tt.seen {
+			t.Errorf("%s at %s: got seen %t, want %t", tt.price.Symbol, tt.price.Timestamp, seen, tt.seen)
+		}
+	}
+}
@@ -1,40 +1,69 @@
 package ticker_price
 
 import (
+	"errors"
+	"strconv"
+	"strings"
 	"time"
 )
 
-// List of known US market holidays (non-exhaustive for example)
-var usMarketHolidays = map[string]struct{}{
-	"2025-01-01": {}, // New Year's Day
-	"2025-01-20": {}, // Martin Luther King Jr. Day
-	"2025-02-17": {}, // Presidents' Day
-	"2025-03-17": {}, // St. Patrick's Day (optional, not market-closed)
-	"2025-05-26": {}, // Memorial Day
-	"2025-07-04": {}, // Independence Day
-	"2025-09-01": {}, // Labor Day
-	"2025-11-11": {}, // Veterans Day
-	"2025-11-27": {}, // Thanksgiving Day
-	"2025-12-25": {}, // Christmas Day
-}
+const defaultHistoryRange = 7 * 24 * time.Hour
 
-func IsTradingHours(t time.Time) bool {
-	utc := t.UTC()
-	dateStr := utc.Format("2006-01-02")
+// ParseTimeRange reads optional RFC3339 bounds, defaulting to the 7 days before to
+func ParseTimeRange(fromValue string, toValue string) (time.Time, time.Time, error) {
+	to := time.Now()
+	if toValue != "" {
+		parsed, err := time.Parse(time.RFC3339, toValue)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.New("invalid 'to', expected RFC3339")
+		}
+		to = parsed
+	}
 
-	// ✋ Skip weekends
-	if utc.Weekday() == time.Saturday || utc.Weekday() == time.Sunday {
-		return false
+	from := to.Add(-defaultHistoryRange)
+	if fromValue != "" {
+		parsed, err := time.Parse(time.RFC3339, fromValue)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.New("invalid 'from', expected RFC3339")
+		}
+		from = parsed
 	}
 
-	// ✋ Skip known market holidays
-	if _, holiday := usMarketHolidays[dateStr]; holiday {
-		return false
+	if from.After(to) {
+		return time.Time{}, time.Time{}, errors.New("'from' must be before 'to'")
 	}
+	return from, to, nil
+}
 
-	// ✅ Market open between 14:30 and 21:00 UTC
-	openingHours := time.Date(utc.Year(), utc.Month(), utc.Day(), 14, 30, 0, 0, time.UTC)
-	closingHours := time.Date(utc.Year(), utc.Month(), utc.Day(), 21, 0, 0, 0, time.UTC)
+// ParseInterval accepts Go durations such as 5m or 1h plus day (1d) and week (1w) units.
+// An empty value or "raw" returns 0, meaning no bucketing.
+func ParseInterval(value string) (time.Duration, error) {
+	value = strings.TrimSpace(strings.ToLower(value))
+	if value == "" || value == "raw" {
+		return 0, nil
+	}
 
-	return utc.After(openingHours) && utc.Before(closingHours)
+	var interval time.Duration
+	switch {
+	case strings.HasSuffix(value, "d") || strings.HasSuffix(value, "w"):
+		count, err := strconv.Atoi(value[:len(value)-1])
+		if err != nil {
+			return 0, errors.New("invalid 'interval'")
+		}
+		interval = time.Duration(count) * 24 * time.Hour
+		if strings.HasSuffix(value, "w") {
+			interval *= 7
+		}
+	default:
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, errors.New("invalid 'interval'")
+		}
+		interval = parsed
+	}
+
+	if interval < time.Minute {
+		return 0, errors.New("'interval' must be at least 1m")
+	}
+	return interval, nil
 }
@@ -34,3 +34,63 @@ func (r *Repository) GetSince(symbol string, since time.Time) ([]models.TickerPr
 		Find(&prices).Error
 	return prices, err
 }
+
+// GetRecentSymbols lists every symbol with at least one price stored since the given time
+func (r *Repository) GetRecentSymbols(since time.Time) ([]string, error) {
+	var symbols []string
+	err := r.db.Model(&models.TickerPrice{}).
+		Where("timestamp >= ?", since).
+		Distinct().
+		Pluck("symbol", &symbols).Error
+	return symbols, err
+}
+
+func (r *Repository) GetRange(symbol string, from time.Time, to time.Time) ([]models.TickerPrice, error) {
+	var prices []models.TickerPrice
+	err := r.db.Where("symbol = ? AND timestamp >= ? AND timestamp <= ?", symbol, from, to).
+		Order("timestamp ASC").
+		Find(&prices).Error
+	return prices, err
+}
+
+// GetCandles aggregates prices into OHLC buckets of the given interval using TimescaleDB's time_bucket
+func (r *Repository) GetCandles(symbol string, from time.Time, to time.Time, interval time.Duration) ([]models.PriceCandle, error) {
+	var candles []models.PriceCandle
+	err := r.db.Raw(`
+		SELECT time_bucket(make_interval(secs => ?), timestamp) AS bucket,
+		       first(price, timestamp) AS open,
+		       max(price) AS high,
+		       min(price) AS low,
+		       last(price, timestamp) AS close,
+		       max(volume) AS volume,
+		       count(*) AS count
+		FROM ticker_prices
+		WHERE symbol = ? AND timestamp >= ? AND timestamp <= ?
+		GROUP BY bucket
+		ORDER BY bucket ASC`,
+		interval.Seconds(), symbol, from, to,
+	).Scan(&candles).Error
+	return candles, err
+}
+
+// GetMoves aggregates the prices of every symbol between from and to into one PriceMove each
+func (r *Repository) GetMoves(symbols []string, from time.Time, to time.Time) ([]models.PriceMove, error) {
+	var moves []models.PriceMove
+	if len(symbols) == 0 {
+		return moves, nil
+	}
+	err := r.db.Raw(`
+		SELECT symbol,
+		       first(price, timestamp) AS open,
+		       max(price) AS high,
+		       min(price) AS low,
+		       last(price, timestamp) AS close,
+		       count(*) AS count
+		FROM ticker_prices
+		WHERE symbol IN ? AND timestamp >= ? AND timestamp <= ?
+		GROUP BY symbol
+		ORDER BY symbol`,
+		symbols, from, to,
+	).Scan(&moves).Error
+	return moves, err
+}
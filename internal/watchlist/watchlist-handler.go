@@ -48,12 +48,15 @@ func (h *Handler) GetAllHandler(w http.ResponseWriter, r *http.Request) {
 
 // CreateHandler handles POST /watchlist
 // @Summary      Create a watchlist entry
-// @Description  Adds a new stock to the watchlist
+// @Description  Adds a new stock to the watchlist, optionally with its own alert config. The exchange
+// @Description  (XNYS, XNAS, XLON, XSES, XHKG or CRYPTO) is inferred from the symbol's suffix when left empty.
+// @Description  session_mode polls in the regular session (default), extended hours (04:00 to 20:00 ET on US exchanges) or always.
 // @Tags         watchlist
 // @Accept       json
 // @Produce      json
 // @Param        ticker  body      Ticker  true  "Ticker to add"
 // @Success      201     {string}  string            "created"
+// @Failure      400     {string}  string            "bad request"
 // @Router       /api/v1/watchlist [post]
 func (h *Handler) CreateHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
@@ -65,6 +68,10 @@ func (h *Handler) CreateHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.Service.CreateTicker(&t); err != nil {
+		if errors.Is(err, ErrInvalidAlertConfig) || errors.Is(err, ErrUnknownExchange) || errors.Is(err, ErrInvalidSessionMode) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
 		http.Error(w, "Failed to create ticker", http.StatusInternalServerError)
 		return
 	}
@@ -80,7 +87,7 @@ func (h *Handler) CreateHandler(w http.ResponseWriter, r *http.Request) {
 
 // UpdateHandler handles PUT /watchlist/{id}
 // @Summary      Update a watchlist entry
-// @Description  Update the symbol or notes for a given watchlist item
+// @Description  Update the symbol, exchange, session mode, notes or alert config for a given watchlist item. The alert config is replaced as a whole, except a muted_until left out keeps the current timed mute. A muted_until in the past lifts it.
 // @Tags         watchlist
 // @Accept       json
 // @Produce      json
@@ -104,6 +111,10 @@ func (h *Handler) UpdateHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.Service.UpdateTicker(uint(id), t); err != nil {
+		if errors.Is(err, ErrInvalidAlertConfig) || errors.Is(err, ErrUnknownExchange) || errors.Is(err, ErrInvalidSessionMode) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
 		http.Error(w, "Failed to update ticker", http.StatusInternalServerError)
 		return
 	}
@@ -11,6 +11,7 @@ type Storage interface {
 	Create(ticker *models.Ticker) error
 	GetAll() ([]models.Ticker, error)
 	GetByID(id uint) (*models.Ticker, error)
+	GetBySymbol(symbol string) (*models.Ticker, error)
 	Update(id uint, updated models.Ticker) error
 	Delete(id uint) error
 }
@@ -42,6 +43,15 @@ func (r *Repository) GetByID(id uint) (*models.Ticker, error) {
 	return &ticker, err
 }
 
+func (r *Repository) GetBySymbol(symbol string) (*models.Ticker, error) {
+	var ticker models.Ticker
+	err := r.db.Where("symbol = ?", symbol).First(&ticker).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &ticker, err
+}
+
 func (r *Repository) Update(id uint, updated models.Ticker) error {
 	var existing models.Ticker
 	if err := r.db.First(&existing, id).Error; err != nil {
@@ -49,15 +59,23 @@ func (r *Repository) Update(id uint, updated models.Ticker) error {
 	}
 
 	existing.Symbol = updated.Symbol
+	existing.Exchange = updated.Exchange
+	existing.SessionMode = updated.SessionMode
 	existing.Notes = updated.Notes
+	existing.Alert = updated.Alert
 	return r.db.Save(&existing).Error
 }
 
-// Delete removes a ticker by ID
+// Delete removes a ticker by ID along with its price alerts
 func (r *Repository) Delete(id uint) error {
-	result := r.db.Delete(&models.Ticker{}, id)
-	if result.RowsAffected == 0 {
-		return errors.New("no record found to delete")
-	}
-	return result.Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&models.Ticker{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("no record found to delete")
+		}
+		return tx.Where("ticker_id = ?", id).Delete(&models.PriceAlert{}).Error
+	})
 }
@@ -1,13 +1,35 @@
 package watchlist
 
-import "github.com/khorzhenwin/gold-digger/internal/models"
+import (
+	"errors"
+	"fmt"
+	"github.com/khorzhenwin/gold-digger/internal/calendar"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"github.com/khorzhenwin/gold-digger/internal/strategy"
+	"strings"
+	"time"
+)
+
+const (
+	maxAlertWindowSize      = 500
+	maxAlertCooldownMinutes = 7 * 24 * 60
+)
+
+var (
+	ErrInvalidAlertConfig = errors.New("invalid alert config")
+	ErrNotOnWatchlist     = errors.New("symbol is not on the watchlist")
+	ErrUnknownExchange    = errors.New("unknown exchange")
+	ErrInvalidSessionMode = errors.New("invalid session mode")
+)
 
 type Service struct {
-	store Storage
+	store    Storage
+	registry *strategy.Registry
 }
 
-func NewService(store Storage) *Service {
-	return &Service{store: store}
+// NewService validates alert strategies against registry, a nil registry skips that check
+func NewService(store Storage, registry *strategy.Registry) *Service {
+	return &Service{store: store, registry: registry}
 }
 
 func (s *Service) FindAll() ([]models.Ticker, error) {
@@ -18,7 +40,26 @@ func (s *Service) FindAll() ([]models.Ticker, error) {
 	return tickers, nil
 }
 
+// FindByID returns nil when the ticker does not exist
+func (s *Service) FindByID(id uint) (*models.Ticker, error) {
+	return s.store.GetByID(id)
+}
+
+// FindBySymbol returns nil when the symbol is not on the watchlist
+func (s *Service) FindBySymbol(symbol string) (*models.Ticker, error) {
+	return s.store.GetBySymbol(symbol)
+}
+
 func (s *Service) CreateTicker(ticker *models.Ticker) error {
+	if err := s.resolveExchange(ticker); err != nil {
+		return err
+	}
+	if err := resolveSessionMode(ticker); err != nil {
+		return err
+	}
+	if err := s.validateAlert(ticker.Alert); err != nil {
+		return err
+	}
 	err := s.store.Create(ticker)
 	if err != nil {
 		return err
@@ -27,9 +68,112 @@ func (s *Service) CreateTicker(ticker *models.Ticker) error {
 }
 
 func (s *Service) UpdateTicker(id uint, updated models.Ticker) error {
+	if err := s.resolveExchange(&updated); err != nil {
+		return err
+	}
+	if err := resolveSessionMode(&updated); err != nil {
+		return err
+	}
+	if err := s.validateAlert(updated.Alert); err != nil {
+		return err
+	}
+
+	// a timed mute set from Telegram survives an update that does not mention it
+	if updated.Alert.MutedUntil == nil {
+		existing, err := s.store.GetByID(id)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			updated.Alert.MutedUntil = existing.Alert.MutedUntil
+		}
+	}
 	return s.store.Update(id, updated)
 }
 
 func (s *Service) DeleteTicker(id uint) error {
 	return s.store.Delete(id)
 }
+
+// MuteUntil silences the signals of symbol until until. A zero time unmutes it, lifting a
+// permanent mute too. It returns ErrNotOnWatchlist when the symbol is not watched.
+func (s *Service) MuteUntil(symbol string, until time.Time) (*models.Ticker, error) {
+	ticker, err := s.store.GetBySymbol(symbol)
+	if err != nil {
+		return nil, err
+	}
+	if ticker == nil {
+		return nil, ErrNotOnWatchlist
+	}
+
+	ticker.Alert.MutedUntil = nil
+	if until.IsZero() {
+		ticker.Alert.Muted = false
+	} else {
+		ticker.Alert.MutedUntil = &until
+	}
+	if err := s.store.Update(ticker.ID, *ticker); err != nil {
+		return nil, err
+	}
+	return ticker, nil
+}
+
+// resolveExchange normalises the ticker's exchange to a known MIC, inferring it from the
+// symbol when it is empty
+func (s *Service) resolveExchange(ticker *models.Ticker) error {
+	if strings.TrimSpace(ticker.Exchange) == "" {
+		ticker.Exchange = calendar.InferMIC(ticker.Symbol)
+		return nil
+	}
+	exchange, ok := calendar.ForMIC(strings.TrimSpace(ticker.Exchange))
+	if !ok {
+		return fmt.Errorf("%w %q, expected one of %s", ErrUnknownExchange, ticker.Exchange, strings.Join(calendar.MICs(), ", "))
+	}
+	ticker.Exchange = exchange.MIC()
+	return nil
+}
+
+// resolveSessionMode normalises the ticker's session mode, empty meaning regular
+func resolveSessionMode(ticker *models.Ticker) error {
+	switch mode := strings.ToLower(strings.TrimSpace(ticker.SessionMode)); mode {
+	case "":
+		ticker.SessionMode = models.SessionModeRegular
+	case models.SessionModeRegular, models.SessionModeExtended, models.SessionModeAlways:
+		ticker.SessionMode = mode
+	default:
+		return fmt.Errorf("%w %q, expected regular, extended or always", ErrInvalidSessionMode, ticker.SessionMode)
+	}
+	return nil
+}
+
+// effectiveWindowSize is the window the signal worker keeps for alert, a window of n prices
+// holds n-1 steps
+func effectiveWindowSize(alert models.AlertConfig) int {
+	if alert.WindowSize > 0 {
+		return alert.WindowSize
+	}
+	return models.DefaultAlertWindowSize
+}
+
+func (s *Service) validateAlert(alert models.AlertConfig) error {
+	switch {
+	case alert.MinChange < 0 || alert.MinChange >= 1:
+		return fmt.Errorf("%w: min_change must be a fraction between 0 and 1", ErrInvalidAlertConfig)
+	case alert.WindowSize < 0 || alert.WindowSize == 1 || alert.WindowSize > maxAlertWindowSize:
+		return fmt.Errorf("%w: window_size must be between 2 and %d", ErrInvalidAlertConfig, maxAlertWindowSize)
+	case alert.MinSteps < 0 || alert.MinSteps >= effectiveWindowSize(alert):
+		return fmt.Errorf("%w: min_steps must be smaller than window_size, %d by default", ErrInvalidAlertConfig, models.DefaultAlertWindowSize)
+	case alert.CooldownMinutes < 0 || alert.CooldownMinutes > maxAlertCooldownMinutes:
+		return fmt.Errorf("%w: cooldown_minutes must be between 0 and %d", ErrInvalidAlertConfig, maxAlertCooldownMinutes)
+	}
+
+	if s.registry != nil {
+		if err := s.registry.Validate(alert.Strategies); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidAlertConfig, err)
+		}
+		if err := s.registry.Validate(alert.ExtendedHoursStrategies); err != nil {
+			return fmt.Errorf("%w: extended_hours_strategies: %v", ErrInvalidAlertConfig, err)
+		}
+	}
+	return nil
+}
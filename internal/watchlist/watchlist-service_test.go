@@ -0,0 +1,39 @@
+package watchlist
+
+import (
+	"errors"
+	"github.com/khorzhenwin/gold-digger/internal/models"
+	"testing"
+)
+
+func TestValidateAlert(t *testing.T) {
+	tests := []struct {
+		name    string
+		alert   models.AlertConfig
+		wantErr bool
+	}{
+		{name: "defaults", alert: models.AlertConfig{}},
+		{name: "min_steps below the default window", alert: models.AlertConfig{MinSteps: models.DefaultAlertWindowSize - 1}},
+		{name: "min_steps filling the default window", alert: models.AlertConfig{MinSteps: models.DefaultAlertWindowSize}, wantErr: true},
+		{name: "min_steps beyond the default window", alert: models.AlertConfig{MinSteps: 25}, wantErr: true},
+		{name: "min_steps within a wider window", alert: models.AlertConfig{MinSteps: 25, WindowSize: 30}},
+		{name: "min_steps filling the window", alert: models.AlertConfig{MinSteps: 5, WindowSize: 5}, wantErr: true},
+		{name: "negative min_steps", alert: models.AlertConfig{MinSteps: -1}, wantErr: true},
+		{name: "single price window", alert: models.AlertConfig{WindowSize: 1}, wantErr: true},
+		{name: "min_change of 100%", alert: models.AlertConfig{MinChange: 1}, wantErr: true},
+		{name: "negative cooldown", alert: models.AlertConfig{CooldownMinutes: -5}, wantErr: true},
+	}
+
+	service := NewService(nil, nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.validateAlert(tt.alert)
+			if tt.wantErr && !errors.Is(err, ErrInvalidAlertConfig) {
+				t.Errorf("got error %v, want ErrInvalidAlertConfig", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("got error %v, want the config accepted", err)
+			}
+		})
+	}
+}
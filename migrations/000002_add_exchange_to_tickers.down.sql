@@ -0,0 +1,5 @@
+ALTER TABLE tickers
+    DROP COLUMN IF EXISTS exchange;
+
+ALTER TABLE tickers
+    ALTER COLUMN symbol TYPE VARCHAR(10);
@@ -0,0 +1,14 @@
+ALTER TABLE tickers
+    ALTER COLUMN symbol TYPE VARCHAR(32);
+
+ALTER TABLE tickers
+    ADD COLUMN IF NOT EXISTS exchange VARCHAR(10) NOT NULL DEFAULT 'XNYS';
+
+UPDATE tickers
+SET exchange = CASE
+                   WHEN symbol LIKE '%.SI' THEN 'XSES'
+                   WHEN symbol LIKE '%.HK' THEN 'XHKG'
+                   WHEN symbol LIKE '%.L' THEN 'XLON'
+                   WHEN symbol LIKE '%-USD' OR symbol LIKE '%/USD' OR symbol LIKE '%-USDT' OR symbol LIKE '%/USDT' THEN 'CRYPTO'
+                   ELSE exchange
+    END;